@@ -0,0 +1,84 @@
+package arena
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/Kinveil/Riot-API-Golang/apiclient"
+	"github.com/Kinveil/Riot-API-Golang/staticdata"
+)
+
+// The game mode of Arena matches.
+const GameMode = "CHERRY"
+
+// Duo is a sub-team of an Arena match. Arena matches have two placeholder teams in MatchInfo.Teams, duos replace them.
+type Duo struct {
+	SubteamID    int16
+	Placement    int16 // 1 for the winning duo
+	Participants []apiclient.MatchInfoParticipant
+}
+
+// Duos groups the participants of an Arena match by sub-team, sorted by placement.
+func Duos(match *apiclient.Match) ([]*Duo, error) {
+	if match.Info.GameMode != GameMode {
+		return nil, fmt.Errorf("match %s is not an Arena match (game mode %s)", match.Metadata.MatchID, match.Info.GameMode)
+	}
+
+	bySubteam := make(map[int16]*Duo)
+	var duos []*Duo
+	for _, participant := range match.Info.Participants {
+		duo, ok := bySubteam[participant.PlayerSubteamID]
+		if !ok {
+			duo = &Duo{SubteamID: participant.PlayerSubteamID}
+			bySubteam[participant.PlayerSubteamID] = duo
+			duos = append(duos, duo)
+		}
+
+		duo.Participants = append(duo.Participants, participant)
+
+		// Older matches only have the placement of each player
+		placement := participant.SubteamPlacement
+		if placement == 0 {
+			placement = participant.Placement
+		}
+
+		if duo.Placement == 0 || (placement != 0 && placement < duo.Placement) {
+			duo.Placement = placement
+		}
+	}
+
+	sort.SliceStable(duos, func(i, j int) bool {
+		return duos[i].Placement < duos[j].Placement
+	})
+
+	return duos, nil
+}
+
+// AugmentIDs returns the augments picked by the participant, in the order they were picked.
+func AugmentIDs(participant apiclient.MatchInfoParticipant) []int32 {
+	var ids []int32
+	for _, id := range []int32{
+		participant.PlayerAugment1,
+		participant.PlayerAugment2,
+		participant.PlayerAugment3,
+		participant.PlayerAugment4,
+	} {
+		if id != 0 {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
+
+// Augments resolves the augments picked by the participant. Augments missing from the static data are skipped.
+func Augments(participant apiclient.MatchInfoParticipant, augments staticdata.ArenaAugments) []staticdata.ArenaAugment {
+	var resolved []staticdata.ArenaAugment
+	for _, id := range AugmentIDs(participant) {
+		if augment, err := augments.Augment(int(id)); err == nil {
+			resolved = append(resolved, augment)
+		}
+	}
+
+	return resolved
+}
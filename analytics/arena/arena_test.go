@@ -0,0 +1,58 @@
+package arena
+
+import (
+	"testing"
+
+	"github.com/Kinveil/Riot-API-Golang/apiclient"
+	"github.com/Kinveil/Riot-API-Golang/staticdata"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDuos(t *testing.T) {
+	participant := func(puuid string, subteamID, placement int16) apiclient.MatchInfoParticipant {
+		return apiclient.MatchInfoParticipant{SummonerPuuid: puuid, PlayerSubteamID: subteamID, SubteamPlacement: placement}
+	}
+
+	match := &apiclient.Match{
+		Info: apiclient.MatchInfo{
+			GameMode: GameMode,
+			Participants: []apiclient.MatchInfoParticipant{
+				participant("a", 1, 3),
+				participant("b", 2, 1),
+				participant("c", 1, 3),
+				participant("d", 2, 1),
+				participant("e", 3, 2),
+				participant("f", 3, 2),
+			},
+		},
+	}
+
+	duos, err := Duos(match)
+	if assert.NoError(t, err) && assert.Len(t, duos, 3) {
+		assert.Equal(t, int16(2), duos[0].SubteamID)
+		assert.Equal(t, "b", duos[0].Participants[0].SummonerPuuid)
+		assert.Equal(t, "d", duos[0].Participants[1].SummonerPuuid)
+		assert.Equal(t, []int16{1, 2, 3}, []int16{duos[0].Placement, duos[1].Placement, duos[2].Placement})
+	}
+
+	match.Info.GameMode = "CLASSIC"
+	_, err = Duos(match)
+	assert.Error(t, err)
+}
+
+func TestAugments(t *testing.T) {
+	augments := staticdata.ArenaAugments{
+		{ID: 1, Name: "Firebrand"},
+		{ID: 7, Name: "Warmup Routine"},
+	}
+
+	participant := apiclient.MatchInfoParticipant{PlayerAugment1: 7, PlayerAugment2: 1, PlayerAugment3: 99}
+
+	assert.Equal(t, []int32{7, 1, 99}, AugmentIDs(participant))
+
+	resolved := Augments(participant, augments)
+	if assert.Len(t, resolved, 2) {
+		assert.Equal(t, "Warmup Routine", resolved[0].Name)
+		assert.Equal(t, "Firebrand", resolved[1].Name)
+	}
+}
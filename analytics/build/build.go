@@ -0,0 +1,297 @@
+package build
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Kinveil/Riot-API-Golang/apiclient"
+	"github.com/Kinveil/Riot-API-Golang/staticdata"
+)
+
+// Items bought before this time are considered starting items.
+const startingItemsWindow = time.Minute
+
+// The number of points needed to max a basic ability.
+const maxSkillPoints = 5
+
+var skillSlotToKey = map[int16]string{
+	1: "Q",
+	2: "W",
+	3: "E",
+	4: "R",
+}
+
+type Purchase struct {
+	ItemID    int32
+	Timestamp time.Duration
+}
+
+type Build struct {
+	ParticipantID  int16
+	Purchases      []Purchase // Every purchase in order, with undone purchases removed
+	Items          []Purchase // Purchases in order, without the components that were built into other items
+	StartingItems  []Purchase // Purchases made in the first minute
+	CompletedItems []Purchase // Purchases of finished items and upgraded boots, in order
+	Boots          *Purchase  // The first upgraded boots, if any
+	SkillOrder     string     // The ability leveled at each level, ex: QWEQQRQ
+	SkillMaxOrder  string     // The order basic abilities were maxed, ex: Q>E>W
+}
+
+type inventoryEntry struct {
+	purchase   Purchase
+	consumed   bool
+	sold       bool
+	components []*inventoryEntry
+}
+
+type builder struct {
+	items   staticdata.Items
+	entries []*inventoryEntry
+	skills  string
+}
+
+// Extract reconstructs the builds of every participant from the timeline, keyed by participant ID.
+// items must be the static data of the match's patch, it is used to tell components from finished items.
+func Extract(timeline *apiclient.MatchTimeline, items staticdata.Items) map[int16]*Build {
+	builders := make(map[int16]*builder)
+	getBuilder := func(participantID int16) *builder {
+		if _, ok := builders[participantID]; !ok {
+			builders[participantID] = &builder{items: items}
+		}
+
+		return builders[participantID]
+	}
+
+	for _, participant := range timeline.Info.Participants {
+		getBuilder(participant.ParticipantID)
+	}
+
+	for _, event := range timeline.Info.Events() {
+		switch e := event.(type) {
+		case *apiclient.MatchTimelineEvent_ItemPurchased:
+			getBuilder(e.ParticipantID).purchase(Purchase{
+				ItemID:    e.ItemID,
+				Timestamp: time.Duration(e.Timestamp) * time.Millisecond,
+			})
+		case *apiclient.MatchTimelineEvent_ItemSold:
+			getBuilder(e.ParticipantID).sell(e.ItemID)
+		case *apiclient.MatchTimelineEvent_ItemUndo:
+			// Undoing a purchase sets BeforeID to the purchased item, undoing a sale sets AfterID instead
+			b := getBuilder(e.ParticipantID)
+			if e.BeforeID != 0 {
+				b.undoPurchase(e.BeforeID)
+			} else if e.AfterID != 0 {
+				b.undoSale(e.AfterID)
+			}
+		case *apiclient.MatchTimelineEvent_SkillLevelUp:
+			if e.LevelUpType != apiclient.MatchTimelineEvent_LevelUpType_Normal {
+				continue
+			}
+
+			if key, ok := skillSlotToKey[e.SkillSlot]; ok {
+				getBuilder(e.ParticipantID).skills += key
+			}
+		}
+	}
+
+	builds := make(map[int16]*Build, len(builders))
+	for participantID, b := range builders {
+		builds[participantID] = b.build(participantID)
+	}
+
+	return builds
+}
+
+func (b *builder) purchase(purchase Purchase) {
+	entry := &inventoryEntry{purchase: purchase}
+
+	// Components are consumed by the recipe; ITEM_DESTROYED events are not needed to tell which ones
+	if item, ok := b.item(purchase.ItemID); ok {
+		b.consumeComponents(entry, item)
+	}
+
+	b.entries = append(b.entries, entry)
+}
+
+// consumeComponents marks the owned components of the item as consumed, looking deeper into the
+// recipe for the components that are not owned.
+func (b *builder) consumeComponents(entry *inventoryEntry, item staticdata.Item) {
+	for _, fromID := range item.From {
+		id, err := strconv.Atoi(fromID)
+		if err != nil {
+			continue
+		}
+
+		if owned := b.lastOwned(int32(id)); owned != nil {
+			owned.consumed = true
+			entry.components = append(entry.components, owned)
+			continue
+		}
+
+		if component, ok := b.item(int32(id)); ok {
+			b.consumeComponents(entry, component)
+		}
+	}
+}
+
+func (b *builder) sell(itemID int32) {
+	if owned := b.lastOwned(itemID); owned != nil {
+		owned.sold = true
+	}
+}
+
+func (b *builder) undoPurchase(itemID int32) {
+	for i := len(b.entries) - 1; i >= 0; i-- {
+		if b.entries[i].purchase.ItemID != itemID {
+			continue
+		}
+
+		for _, component := range b.entries[i].components {
+			component.consumed = false
+		}
+
+		b.entries = append(b.entries[:i], b.entries[i+1:]...)
+		return
+	}
+}
+
+func (b *builder) undoSale(itemID int32) {
+	for i := len(b.entries) - 1; i >= 0; i-- {
+		if b.entries[i].purchase.ItemID == itemID && b.entries[i].sold {
+			b.entries[i].sold = false
+			return
+		}
+	}
+}
+
+// lastOwned returns the most recent purchase of the item that is still in the inventory.
+func (b *builder) lastOwned(itemID int32) *inventoryEntry {
+	for i := len(b.entries) - 1; i >= 0; i-- {
+		entry := b.entries[i]
+		if entry.purchase.ItemID == itemID && !entry.consumed && !entry.sold {
+			return entry
+		}
+	}
+
+	return nil
+}
+
+func (b *builder) item(itemID int32) (staticdata.Item, bool) {
+	item, ok := b.items.Data[strconv.Itoa(int(itemID))]
+	return item, ok
+}
+
+func (b *builder) build(participantID int16) *Build {
+	result := &Build{
+		ParticipantID: participantID,
+		SkillOrder:    b.skills,
+		SkillMaxOrder: skillMaxOrder(b.skills),
+	}
+
+	for _, entry := range b.entries {
+		purchase := entry.purchase
+		result.Purchases = append(result.Purchases, purchase)
+
+		if purchase.Timestamp < startingItemsWindow {
+			result.StartingItems = append(result.StartingItems, purchase)
+		}
+
+		if !entry.consumed {
+			result.Items = append(result.Items, purchase)
+		}
+
+		item, ok := b.item(purchase.ItemID)
+		if !ok || !isCompleted(item) {
+			continue
+		}
+
+		result.CompletedItems = append(result.CompletedItems, purchase)
+		if result.Boots == nil && hasTag(item, "Boots") {
+			result.Boots = &purchase
+		}
+	}
+
+	return result
+}
+
+// isCompleted reports whether the item is built from components and is not itself a component.
+// Upgraded boots are considered completed even though some of them can be upgraded again.
+func isCompleted(item staticdata.Item) bool {
+	if len(item.From) == 0 {
+		return false
+	}
+
+	return len(item.Into) == 0 || hasTag(item, "Boots")
+}
+
+func hasTag(item staticdata.Item, tag string) bool {
+	for _, t := range item.Tags {
+		if t == tag {
+			return true
+		}
+	}
+
+	return false
+}
+
+// skillMaxOrder ranks the basic abilities by the level at which they were maxed.
+// Abilities that were never maxed are ranked by the number of points, then by when they were first leveled.
+func skillMaxOrder(skillOrder string) string {
+	type skill struct {
+		key        string
+		points     int
+		first      int
+		maxedLevel int
+	}
+
+	skills := []*skill{{key: "Q"}, {key: "W"}, {key: "E"}}
+	for _, s := range skills {
+		s.first = -1
+		s.maxedLevel = len(skillOrder)
+	}
+
+	for level, r := range skillOrder {
+		for _, s := range skills {
+			if s.key != string(r) {
+				continue
+			}
+
+			s.points++
+			if s.first == -1 {
+				s.first = level
+			}
+
+			if s.points == maxSkillPoints {
+				s.maxedLevel = level
+			}
+		}
+	}
+
+	var leveled []*skill
+	for _, s := range skills {
+		if s.points > 0 {
+			leveled = append(leveled, s)
+		}
+	}
+
+	sort.SliceStable(leveled, func(i, j int) bool {
+		if leveled[i].maxedLevel != leveled[j].maxedLevel {
+			return leveled[i].maxedLevel < leveled[j].maxedLevel
+		}
+
+		if leveled[i].points != leveled[j].points {
+			return leveled[i].points > leveled[j].points
+		}
+
+		return leveled[i].first < leveled[j].first
+	})
+
+	keys := make([]string, len(leveled))
+	for i, s := range leveled {
+		keys[i] = s.key
+	}
+
+	return strings.Join(keys, ">")
+}
@@ -0,0 +1,81 @@
+package build
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Kinveil/Riot-API-Golang/apiclient"
+	"github.com/Kinveil/Riot-API-Golang/staticdata"
+	"github.com/stretchr/testify/assert"
+)
+
+func testItems() staticdata.Items {
+	return staticdata.Items{
+		Data: map[string]staticdata.Item{
+			"1001": {Name: "Boots", Into: []string{"3006"}, Tags: []string{"Boots"}},
+			"1036": {Name: "Long Sword", Into: []string{"3133"}},
+			"1055": {Name: "Doran's Blade"},
+			"3006": {Name: "Berserker's Greaves", From: []string{"1001", "1042"}, Tags: []string{"Boots"}},
+			"3133": {Name: "Caulfield's Warhammer", From: []string{"1036", "1036"}, Into: []string{"6692"}},
+			"6692": {Name: "Eclipse", From: []string{"3133", "1036"}},
+		},
+	}
+}
+
+func TestExtract(t *testing.T) {
+	minute := int32(60000)
+	events := []apiclient.MatchTimelineEvent{
+		&apiclient.MatchTimelineEvent_ItemPurchased{ParticipantID: 1, ItemID: 1055, Timestamp: 1000},
+		&apiclient.MatchTimelineEvent_ItemPurchased{ParticipantID: 1, ItemID: 1036, Timestamp: 5 * minute},
+		&apiclient.MatchTimelineEvent_ItemPurchased{ParticipantID: 1, ItemID: 1001, Timestamp: 5 * minute},
+		&apiclient.MatchTimelineEvent_ItemPurchased{ParticipantID: 1, ItemID: 1036, Timestamp: 5 * minute},
+		&apiclient.MatchTimelineEvent_ItemUndo{ParticipantID: 1, BeforeID: 1036, Timestamp: 5 * minute},
+		&apiclient.MatchTimelineEvent_ItemPurchased{ParticipantID: 1, ItemID: 6692, Timestamp: 12 * minute},
+		&apiclient.MatchTimelineEvent_ItemSold{ParticipantID: 1, ItemID: 1055, Timestamp: 12 * minute},
+		&apiclient.MatchTimelineEvent_ItemPurchased{ParticipantID: 1, ItemID: 3006, Timestamp: 14 * minute},
+	}
+
+	skills := "QWEQQRQWQWRWWEEREE"
+	for i, r := range skills {
+		slot := map[rune]int16{'Q': 1, 'W': 2, 'E': 3, 'R': 4}[r]
+		events = append(events, &apiclient.MatchTimelineEvent_SkillLevelUp{
+			ParticipantID: 1,
+			SkillSlot:     slot,
+			LevelUpType:   apiclient.MatchTimelineEvent_LevelUpType_Normal,
+			Timestamp:     int32(i) * minute,
+		})
+	}
+
+	timeline := &apiclient.MatchTimeline{
+		Info: apiclient.MatchTimelineInfo{
+			Frames: []apiclient.MatchTimelineFrame{{Events: events}},
+		},
+	}
+
+	builds := Extract(timeline, testItems())
+	b := builds[1]
+
+	assert.Len(t, b.Purchases, 5)
+	assert.Equal(t, []Purchase{
+		{ItemID: 1055, Timestamp: time.Second},
+		{ItemID: 6692, Timestamp: 12 * time.Minute},
+		{ItemID: 3006, Timestamp: 14 * time.Minute},
+	}, b.Items)
+	assert.Equal(t, []Purchase{{ItemID: 1055, Timestamp: time.Second}}, b.StartingItems)
+	assert.Equal(t, []Purchase{
+		{ItemID: 6692, Timestamp: 12 * time.Minute},
+		{ItemID: 3006, Timestamp: 14 * time.Minute},
+	}, b.CompletedItems)
+	if assert.NotNil(t, b.Boots) {
+		assert.Equal(t, 14*time.Minute, b.Boots.Timestamp)
+	}
+
+	assert.Equal(t, skills, b.SkillOrder)
+	assert.Equal(t, "Q>W>E", b.SkillMaxOrder)
+}
+
+func TestSkillMaxOrder(t *testing.T) {
+	assert.Equal(t, "Q>E>W", skillMaxOrder("QEWQQRQEQEREEWWRWW"))
+	assert.Equal(t, "E>Q", skillMaxOrder("EQEE"))
+	assert.Equal(t, "", skillMaxOrder(""))
+}
@@ -0,0 +1,122 @@
+package game_status
+
+import (
+	"time"
+
+	"github.com/Kinveil/Riot-API-Golang/apiclient"
+)
+
+type Status string
+
+const (
+	Normal         Status = "NORMAL"
+	Remake         Status = "REMAKE"
+	EarlySurrender Status = "EARLY_SURRENDER"
+	LeaverDetected Status = "LEAVER_DETECTED"
+	Custom         Status = "CUSTOM"
+)
+
+const (
+	// Games that end before this are remakes, even when the remake flag is missing
+	remakeDuration = 5 * time.Minute
+	// Participants who played less than this share of the game left early
+	leaverTimePlayedRatio = 0.8
+	// Participants with no items after this long were likely AFK from the start
+	noItemsDuration = 10 * time.Minute
+)
+
+type PlayerStatus struct {
+	ParticipantID int16
+	Puuid         string
+	TeamID        int16
+	TimePlayed    time.Duration
+	LeftEarly     bool // Played less than 80% of the game
+	NoItems       bool // Ended a game longer than 10 minutes without items
+}
+
+// Leaver reports whether the player left or was AFK.
+func (p *PlayerStatus) Leaver() bool {
+	return p.LeftEarly || p.NoItems
+}
+
+type Classification struct {
+	Status   Status
+	Duration time.Duration
+	Players  []*PlayerStatus
+}
+
+// Normal reports whether the game can be used for stats.
+func (c *Classification) Normal() bool {
+	return c.Status == Normal
+}
+
+// Leavers returns the players that left or were AFK.
+func (c *Classification) Leavers() []*PlayerStatus {
+	var leavers []*PlayerStatus
+	for _, p := range c.Players {
+		if p.Leaver() {
+			leavers = append(leavers, p)
+		}
+	}
+
+	return leavers
+}
+
+// Classify tells normal games apart from custom games, remakes, early surrenders and games with leavers.
+// Players are flagged as leavers in every game, but only games that are otherwise normal are given the
+// LeaverDetected status.
+func Classify(match *apiclient.Match) *Classification {
+	c := &Classification{
+		Status:   Normal,
+		Duration: match.Info.Duration(),
+	}
+
+	var remake, earlySurrender bool
+	for _, participant := range match.Info.Participants {
+		// Despite its name, the early surrender flag is set on the participants of a remake
+		remake = remake || participant.GameEndedInEarlySurrender
+		// Set on the team that surrendered early, regular surrenders from 15 minutes on are normal games
+		earlySurrender = earlySurrender || participant.TeamEarlySurrendered
+
+		c.Players = append(c.Players, classifyPlayer(participant, c.Duration))
+	}
+
+	switch {
+	case match.Info.GameType == "CUSTOM_GAME":
+		c.Status = Custom
+	case remake || c.Duration < remakeDuration:
+		c.Status = Remake
+	case earlySurrender:
+		c.Status = EarlySurrender
+	case len(c.Leavers()) > 0:
+		c.Status = LeaverDetected
+	}
+
+	return c
+}
+
+func classifyPlayer(participant apiclient.MatchInfoParticipant, duration time.Duration) *PlayerStatus {
+	p := &PlayerStatus{
+		ParticipantID: participant.ParticipantID,
+		Puuid:         participant.SummonerPuuid,
+		TeamID:        participant.TeamID,
+		TimePlayed:    time.Duration(participant.TimePlayed) * time.Second,
+	}
+
+	// Old matches have no time played
+	if participant.TimePlayed > 0 {
+		p.LeftEarly = p.TimePlayed.Seconds() < duration.Seconds()*leaverTimePlayedRatio
+	}
+
+	if duration >= noItemsDuration {
+		p.NoItems = true
+		for _, item := range []int32{participant.Item0, participant.Item1, participant.Item2, participant.Item3, participant.Item4, participant.Item5} {
+			if item != 0 {
+				p.NoItems = false
+				break
+			}
+		}
+	}
+
+	return p
+}
@@ -0,0 +1,65 @@
+package game_status
+
+import (
+	"testing"
+
+	"github.com/Kinveil/Riot-API-Golang/apiclient"
+	"github.com/stretchr/testify/assert"
+)
+
+func testMatch(duration int32) *apiclient.Match {
+	match := &apiclient.Match{
+		Info: apiclient.MatchInfo{
+			GameType:         "MATCHED_GAME",
+			GameDuration:     duration,
+			GameEndTimestamp: 1700000000000,
+		},
+	}
+
+	for i := int16(1); i <= 10; i++ {
+		match.Info.Participants = append(match.Info.Participants, apiclient.MatchInfoParticipant{
+			ParticipantID: i,
+			TimePlayed:    int32(duration),
+			Item0:         1055,
+		})
+	}
+
+	return match
+}
+
+func TestClassify(t *testing.T) {
+	assert.Equal(t, Normal, Classify(testMatch(1800)).Status)
+	assert.True(t, Classify(testMatch(1800)).Normal())
+
+	custom := testMatch(1800)
+	custom.Info.GameType = "CUSTOM_GAME"
+	assert.Equal(t, Custom, Classify(custom).Status)
+
+	assert.Equal(t, Remake, Classify(testMatch(200)).Status)
+
+	remake := testMatch(400)
+	remake.Info.Participants[0].GameEndedInEarlySurrender = true
+	assert.Equal(t, Remake, Classify(remake).Status)
+
+	earlySurrender := testMatch(600)
+	earlySurrender.Info.Participants[0].GameEndedInSurrender = true
+	earlySurrender.Info.Participants[0].TeamEarlySurrendered = true
+	assert.Equal(t, EarlySurrender, Classify(earlySurrender).Status)
+
+	// The surrender vote opens at 15 minutes
+	surrender := testMatch(960)
+	surrender.Info.Participants[0].GameEndedInSurrender = true
+	assert.Equal(t, Normal, Classify(surrender).Status)
+
+	leavers := testMatch(1800)
+	leavers.Info.Participants[3].TimePlayed = 600
+	leavers.Info.Participants[7].Item0 = 0
+	c := Classify(leavers)
+	assert.Equal(t, LeaverDetected, c.Status)
+	if assert.Len(t, c.Leavers(), 2) {
+		assert.True(t, c.Leavers()[0].LeftEarly)
+		assert.Equal(t, int16(4), c.Leavers()[0].ParticipantID)
+		assert.True(t, c.Leavers()[1].NoItems)
+		assert.Equal(t, int16(8), c.Leavers()[1].ParticipantID)
+	}
+}
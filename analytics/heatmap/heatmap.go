@@ -0,0 +1,186 @@
+package heatmap
+
+import (
+	"fmt"
+
+	"github.com/Kinveil/Riot-API-Golang/apiclient"
+)
+
+// Map holds the bounds of a map in game coordinates.
+type Map struct {
+	ID   int16
+	Name string
+	MinX float64
+	MinY float64
+	MaxX float64
+	MaxY float64
+}
+
+var (
+	SummonersRift = Map{ID: 11, Name: "Summoner's Rift", MinX: -120, MinY: -120, MaxX: 14870, MaxY: 14980}
+	HowlingAbyss  = Map{ID: 12, Name: "Howling Abyss", MinX: -28, MinY: -19, MaxX: 12849, MaxY: 12858}
+)
+
+// MapByID returns the map with the given ID, as found in MatchInfo.MapID.
+func MapByID(mapID int16) (Map, error) {
+	switch mapID {
+	case SummonersRift.ID:
+		return SummonersRift, nil
+	case HowlingAbyss.ID:
+		return HowlingAbyss, nil
+	default:
+		return Map{}, fmt.Errorf("unsupported map ID: %d", mapID)
+	}
+}
+
+// Normalize converts a position to map-relative coordinates between 0 and 1.
+func (m Map) Normalize(pos apiclient.MatchTimelinePosition) (float64, float64) {
+	x := (float64(pos.X) - m.MinX) / (m.MaxX - m.MinX)
+	y := (float64(pos.Y) - m.MinY) / (m.MaxY - m.MinY)
+	return clamp(x), clamp(y)
+}
+
+func clamp(v float64) float64 {
+	if v < 0 {
+		return 0
+	}
+
+	if v > 1 {
+		return 1
+	}
+
+	return v
+}
+
+// Grid is a heatmap of a map divided in Width x Height cells.
+// Cells are stored row by row, the first row being the top of the map.
+type Grid struct {
+	Map    Map
+	Width  int
+	Height int
+	Cells  []float64
+}
+
+func NewGrid(m Map, width, height int) *Grid {
+	return &Grid{
+		Map:    m,
+		Width:  width,
+		Height: height,
+		Cells:  make([]float64, width*height),
+	}
+}
+
+// Add adds weight to the cell containing the position.
+func (g *Grid) Add(pos apiclient.MatchTimelinePosition, weight float64) {
+	if g.Width == 0 || g.Height == 0 {
+		return
+	}
+
+	x, y := g.Map.Normalize(pos)
+
+	col := int(x * float64(g.Width))
+	if col == g.Width {
+		col--
+	}
+
+	// Game coordinates grow upwards, rows grow downwards
+	row := int((1 - y) * float64(g.Height))
+	if row == g.Height {
+		row--
+	}
+
+	g.Cells[row*g.Width+col] += weight
+}
+
+// At returns the value of the cell at the column and row.
+func (g *Grid) At(col, row int) float64 {
+	return g.Cells[row*g.Width+col]
+}
+
+// Max returns the highest cell value.
+func (g *Grid) Max() float64 {
+	var max float64
+	for _, v := range g.Cells {
+		if v > max {
+			max = v
+		}
+	}
+
+	return max
+}
+
+// Normalized returns a copy of the grid with values scaled between 0 and 1.
+func (g *Grid) Normalized() *Grid {
+	normalized := NewGrid(g.Map, g.Width, g.Height)
+
+	max := g.Max()
+	if max == 0 {
+		return normalized
+	}
+
+	for i, v := range g.Cells {
+		normalized.Cells[i] = v / max
+	}
+
+	return normalized
+}
+
+// FromFrames builds a heatmap of the participants' positions in every timeline frame.
+// If no participant IDs are given, every participant is included.
+func FromFrames(m Map, width, height int, timeline *apiclient.MatchTimeline, participantIDs ...int16) *Grid {
+	grid := NewGrid(m, width, height)
+	include := participantFilter(participantIDs)
+
+	for _, frame := range timeline.Info.Frames {
+		for _, pf := range frame.ParticipantFrames {
+			if include(pf.ParticipantID) {
+				grid.Add(pf.Position, 1)
+			}
+		}
+	}
+
+	return grid
+}
+
+// FromKills builds a heatmap of the champion kills scored by the participants.
+// If no participant IDs are given, every kill is included.
+func FromKills(m Map, width, height int, timeline *apiclient.MatchTimeline, participantIDs ...int16) *Grid {
+	grid := NewGrid(m, width, height)
+	include := participantFilter(participantIDs)
+
+	for _, kill := range apiclient.EventsOfType[*apiclient.MatchTimelineEvent_ChampionKill](timeline.Info.Events()) {
+		if include(kill.KillerID) {
+			grid.Add(kill.Position, 1)
+		}
+	}
+
+	return grid
+}
+
+// FromDeaths builds a heatmap of the deaths of the participants.
+// If no participant IDs are given, every death is included.
+func FromDeaths(m Map, width, height int, timeline *apiclient.MatchTimeline, participantIDs ...int16) *Grid {
+	grid := NewGrid(m, width, height)
+	include := participantFilter(participantIDs)
+
+	for _, kill := range apiclient.EventsOfType[*apiclient.MatchTimelineEvent_ChampionKill](timeline.Info.Events()) {
+		if include(kill.VictimID) {
+			grid.Add(kill.Position, 1)
+		}
+	}
+
+	return grid
+}
+
+func participantFilter(participantIDs []int16) func(int16) bool {
+	if len(participantIDs) == 0 {
+		return func(int16) bool { return true }
+	}
+
+	ids := make(map[int16]bool, len(participantIDs))
+	for _, id := range participantIDs {
+		ids[id] = true
+	}
+
+	return func(id int16) bool { return ids[id] }
+}
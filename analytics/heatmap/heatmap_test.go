@@ -0,0 +1,73 @@
+package heatmap
+
+import (
+	"bytes"
+	"image/png"
+	"testing"
+	"time"
+
+	"github.com/Kinveil/Riot-API-Golang/apiclient"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGrid(t *testing.T) {
+	grid := NewGrid(SummonersRift, 4, 4)
+	grid.Add(apiclient.MatchTimelinePosition{X: 0, Y: 0}, 1)
+	grid.Add(apiclient.MatchTimelinePosition{X: 14870, Y: 14980}, 1)
+	grid.Add(apiclient.MatchTimelinePosition{X: 14000, Y: 14000}, 2)
+
+	assert.Equal(t, float64(1), grid.At(0, 3))
+	assert.Equal(t, float64(3), grid.At(3, 0))
+	assert.Equal(t, float64(1), grid.Normalized().At(3, 0))
+
+	var buf bytes.Buffer
+	assert.NoError(t, grid.WritePNG(&buf, 8))
+
+	img, err := png.Decode(&buf)
+	if assert.NoError(t, err) {
+		assert.Equal(t, 32, img.Bounds().Dx())
+		assert.Equal(t, 32, img.Bounds().Dy())
+	}
+}
+
+func TestPathing(t *testing.T) {
+	frame := func(minute int32, x, y int16) apiclient.MatchTimelineFrame {
+		return apiclient.MatchTimelineFrame{
+			Timestamp: minute * 60000,
+			ParticipantFrames: apiclient.MatchTimelineParticipantFrames{
+				{ParticipantID: 2, Position: apiclient.MatchTimelinePosition{X: x, Y: y}},
+			},
+		}
+	}
+
+	timeline := &apiclient.MatchTimeline{
+		Info: apiclient.MatchTimelineInfo{
+			Frames: []apiclient.MatchTimelineFrame{
+				frame(0, 500, 500),
+				frame(1, 3000, 6500),
+				frame(2, 3800, 7900),
+				frame(3, 7700, 4000),
+				frame(4, 7200, 7300),
+			},
+		},
+	}
+
+	timeline.Info.Frames[3].Events = []apiclient.MatchTimelineEvent{
+		&apiclient.MatchTimelineEvent_ChampionKill{
+			KillerID:                3,
+			VictimID:                8,
+			AssistingParticipantIDs: []int16{2},
+			Position:                apiclient.MatchTimelinePosition{X: 6900, Y: 7100},
+			Timestamp:               220000,
+		},
+	}
+
+	path := Pathing(timeline, 2)
+	assert.Len(t, path.Path, 5)
+	assert.Equal(t, TopSide, path.FirstClearSide)
+	if assert.NotNil(t, path.FirstGank) {
+		assert.Equal(t, apiclient.MatchTimelineEvent_LaneType_MidLane, path.FirstGank.Lane)
+		assert.Equal(t, 220*time.Second, path.FirstGank.Timestamp)
+		assert.True(t, path.FirstGank.Kill)
+	}
+}
@@ -0,0 +1,73 @@
+package heatmap
+
+import (
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+)
+
+// Colors of the heatmap from the coldest to the hottest cell.
+var palette = []color.NRGBA{
+	{R: 0, G: 0, B: 255, A: 0},
+	{R: 0, G: 0, B: 255, A: 160},
+	{R: 0, G: 255, B: 0, A: 200},
+	{R: 255, G: 255, B: 0, A: 230},
+	{R: 255, G: 0, B: 0, A: 255},
+}
+
+// Image renders the grid with each cell scaled to cellSize x cellSize pixels.
+// Empty cells are transparent so the image can be drawn over a map.
+func (g *Grid) Image(cellSize int) *image.NRGBA {
+	if cellSize < 1 {
+		cellSize = 1
+	}
+
+	img := image.NewNRGBA(image.Rect(0, 0, g.Width*cellSize, g.Height*cellSize))
+	normalized := g.Normalized()
+
+	for row := 0; row < g.Height; row++ {
+		for col := 0; col < g.Width; col++ {
+			c := colorAt(normalized.At(col, row))
+			for y := row * cellSize; y < (row+1)*cellSize; y++ {
+				for x := col * cellSize; x < (col+1)*cellSize; x++ {
+					img.SetNRGBA(x, y, c)
+				}
+			}
+		}
+	}
+
+	return img
+}
+
+// WritePNG encodes the grid as a PNG image, see Image.
+func (g *Grid) WritePNG(w io.Writer, cellSize int) error {
+	return png.Encode(w, g.Image(cellSize))
+}
+
+// colorAt interpolates the palette for a value between 0 and 1.
+func colorAt(v float64) color.NRGBA {
+	if v <= 0 {
+		return palette[0]
+	}
+
+	if v >= 1 {
+		return palette[len(palette)-1]
+	}
+
+	pos := v * float64(len(palette)-1)
+	i := int(pos)
+	t := pos - float64(i)
+
+	from, to := palette[i], palette[i+1]
+	lerp := func(a, b uint8) uint8 {
+		return uint8(float64(a) + (float64(b)-float64(a))*t)
+	}
+
+	return color.NRGBA{
+		R: lerp(from.R, to.R),
+		G: lerp(from.G, to.G),
+		B: lerp(from.B, to.B),
+		A: lerp(from.A, to.A),
+	}
+}
@@ -0,0 +1,143 @@
+package heatmap
+
+import (
+	"math"
+	"time"
+
+	"github.com/Kinveil/Riot-API-Golang/apiclient"
+)
+
+// Side is a half of Summoner's Rift, split along the mid lane: the top side holds the top lane, the bottom side the bot lane.
+type Side string
+
+const (
+	TopSide    Side = "TOP"
+	BottomSide Side = "BOTTOM"
+)
+
+const (
+	// Jungle camps spawn at 1:30, the 2:00 frame shows where the jungler started their clear
+	firstCampTime = 2 * time.Minute
+	// Ganks after this time are not considered part of the early game
+	earlyGameEnd = 10 * time.Minute
+	// Positions this close to a fountain are in a base rather than a lane
+	baseRadius = 4500
+	// Distance from the map edges and the mid lane diagonal that counts as being in a lane
+	laneWidth = 1800
+)
+
+type PathPoint struct {
+	Timestamp time.Duration
+	Position  apiclient.MatchTimelinePosition
+}
+
+type Gank struct {
+	Timestamp time.Duration
+	Lane      apiclient.MatchTimelineEvent_LaneType
+	Kill      bool // Whether the jungler killed, assisted or died in the lane
+}
+
+type JunglePath struct {
+	ParticipantID  int16
+	Path           []PathPoint // Frame positions during the early game
+	FirstClearSide Side        // Empty if the timeline has no frame after the first camps spawned
+	FirstGank      *Gank       // Nil if the jungler did not visit a lane during the early game
+}
+
+// Pathing computes the early game jungle pathing of the participant on Summoner's Rift.
+// The first gank is the earliest champion kill the jungler took part in, or frame the jungler was seen in,
+// inside a lane after the first camp.
+func Pathing(timeline *apiclient.MatchTimeline, participantID int16) *JunglePath {
+	path := &JunglePath{ParticipantID: participantID}
+
+	for _, frame := range timeline.Info.Frames {
+		timestamp := time.Duration(frame.Timestamp) * time.Millisecond
+		if timestamp > earlyGameEnd {
+			break
+		}
+
+		pf, ok := frame.ParticipantFrames.ByParticipantID(participantID)
+		if !ok {
+			continue
+		}
+
+		path.Path = append(path.Path, PathPoint{Timestamp: timestamp, Position: pf.Position})
+
+		if path.FirstClearSide == "" && timestamp >= firstCampTime {
+			path.FirstClearSide = sideOf(pf.Position)
+		}
+
+		if path.FirstGank == nil && timestamp > firstCampTime {
+			if lane := LaneAt(pf.Position); lane != "" {
+				path.FirstGank = &Gank{Timestamp: timestamp, Lane: lane}
+			}
+		}
+	}
+
+	for _, kill := range apiclient.EventsOfType[*apiclient.MatchTimelineEvent_ChampionKill](timeline.Info.Events()) {
+		timestamp := time.Duration(kill.Timestamp) * time.Millisecond
+		if timestamp > earlyGameEnd || (path.FirstGank != nil && timestamp > path.FirstGank.Timestamp) {
+			break
+		}
+
+		if timestamp <= firstCampTime || !involves(kill, participantID) {
+			continue
+		}
+
+		if lane := LaneAt(kill.Position); lane != "" {
+			path.FirstGank = &Gank{Timestamp: timestamp, Lane: lane, Kill: true}
+			break
+		}
+	}
+
+	return path
+}
+
+// sideOf returns the side of the mid lane the position is on, the mid lane runs from the bottom left to the top right.
+func sideOf(pos apiclient.MatchTimelinePosition) Side {
+	if pos.Y > pos.X {
+		return TopSide
+	}
+
+	return BottomSide
+}
+
+// InBase reports whether the position is in one of the bases of Summoner's Rift.
+func InBase(pos apiclient.MatchTimelinePosition) bool {
+	x, y := float64(pos.X), float64(pos.Y)
+	return math.Hypot(x, y) < baseRadius || math.Hypot(SummonersRift.MaxX-x, SummonersRift.MaxY-y) < baseRadius
+}
+
+// LaneAt returns the lane the position is in on Summoner's Rift, or an empty string if it is in the jungle or a base.
+func LaneAt(pos apiclient.MatchTimelinePosition) apiclient.MatchTimelineEvent_LaneType {
+	if InBase(pos) {
+		return ""
+	}
+
+	x, y := float64(pos.X), float64(pos.Y)
+
+	switch {
+	case x < laneWidth || y > SummonersRift.MaxY-laneWidth:
+		return apiclient.MatchTimelineEvent_LaneType_TopLane
+	case y < laneWidth || x > SummonersRift.MaxX-laneWidth:
+		return apiclient.MatchTimelineEvent_LaneType_BotLane
+	case math.Abs(x-y) < laneWidth:
+		return apiclient.MatchTimelineEvent_LaneType_MidLane
+	default:
+		return ""
+	}
+}
+
+func involves(kill *apiclient.MatchTimelineEvent_ChampionKill, participantID int16) bool {
+	if kill.KillerID == participantID || kill.VictimID == participantID {
+		return true
+	}
+
+	for _, id := range kill.AssistingParticipantIDs {
+		if id == participantID {
+			return true
+		}
+	}
+
+	return false
+}
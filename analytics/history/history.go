@@ -0,0 +1,431 @@
+package history
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/Kinveil/Riot-API-Golang/analytics/game_status"
+	"github.com/Kinveil/Riot-API-Golang/apiclient"
+	"github.com/Kinveil/Riot-API-Golang/constants/continent"
+	"github.com/Kinveil/Riot-API-Golang/constants/queue"
+)
+
+const (
+	// History is fetched below the priority of user facing requests by default
+	defaultPriority = -1
+	// Matches never change, so they can stay in the cache for a while
+	fetchCacheDuration = time.Hour
+
+	defaultCount       = 20
+	defaultConcurrency = 4
+	recentRankedLimit  = 20
+)
+
+type Filters struct {
+	Count           int        // Number of most recent matches the aggregates cover, defaults to 20
+	Queue           *queue.ID  // Only matches of this queue
+	StartTime       *time.Time // Only matches played after this time
+	ExcludeAbnormal bool       // Skip remakes, early surrenders, games with leavers and custom games
+	Concurrency     int        // Number of matches fetched at the same time, defaults to 4
+	Priority        *int       // Priority of the requests, defaults to -1 so that regular requests are served first
+}
+
+// Record is the aggregate of a set of games.
+type Record struct {
+	Games   int
+	Wins    int
+	Kills   int
+	Deaths  int
+	Assists int
+}
+
+func (r Record) Winrate() float64 {
+	if r.Games == 0 {
+		return 0
+	}
+
+	return float64(r.Wins) / float64(r.Games)
+}
+
+// KDA returns (Kills + Assists) / Deaths, with at least one death.
+func (r Record) KDA() float64 {
+	deaths := r.Deaths
+	if deaths == 0 {
+		deaths = 1
+	}
+
+	return float64(r.Kills+r.Assists) / float64(deaths)
+}
+
+func (r *Record) add(game *Game) {
+	r.Games++
+	if game.Win {
+		r.Wins++
+	}
+
+	r.Kills += int(game.Kills)
+	r.Deaths += int(game.Deaths)
+	r.Assists += int(game.Assists)
+}
+
+// Game is what a match adds to the aggregates, kept so that the aggregates can drop it once it is too old.
+type Game struct {
+	MatchID      apiclient.MatchID
+	QueueID      queue.ID
+	GameCreation time.Time
+	Normal       bool // False for remakes, early surrenders, games with leavers and custom games
+	Played       bool // False if the player is not in the match
+	ChampionName string
+	TeamPosition string
+	Win          bool
+	Kills        int16
+	Deaths       int16
+	Assists      int16
+	Teammates    []GameTeammate
+}
+
+type GameTeammate struct {
+	Puuid    string
+	GameName string
+	TagLine  string
+}
+
+type Teammate struct {
+	Puuid    string
+	GameName string
+	TagLine  string
+	Games    int
+	Wins     int
+}
+
+type RankedGame struct {
+	MatchID      apiclient.MatchID
+	QueueID      queue.ID
+	GameCreation time.Time
+	ChampionName string
+	Win          bool
+	Kills        int16
+	Deaths       int16
+	Assists      int16
+}
+
+// State holds the aggregates of a player's most recent matches. It can be stored as JSON and passed back to Update
+// so that only new matches are fetched, matches that are no longer among the most recent ones are then dropped.
+type State struct {
+	Puuid        string
+	Games        []*Game // Newest first
+	Total        Record
+	Champions    map[string]*Record   // Keyed by champion name
+	Positions    map[string]*Record   // Keyed by team position, ex: MIDDLE
+	Queues       map[queue.ID]*Record // Keyed by queue ID
+	Teammates    map[string]*Teammate // Keyed by PUUID
+	RecentRanked []RankedGame         // Ranked games affecting LP, newest first
+}
+
+func NewState(puuid string) *State {
+	s := &State{Puuid: puuid}
+	s.init()
+	return s
+}
+
+// init creates the maps missing from a state that was decoded from JSON.
+func (s *State) init() {
+	if s.Champions == nil {
+		s.Champions = make(map[string]*Record)
+	}
+
+	if s.Positions == nil {
+		s.Positions = make(map[string]*Record)
+	}
+
+	if s.Queues == nil {
+		s.Queues = make(map[queue.ID]*Record)
+	}
+
+	if s.Teammates == nil {
+		s.Teammates = make(map[string]*Teammate)
+	}
+}
+
+// MostPlayedTeammates returns the n teammates the player played the most games with, or all of them if n is negative.
+func (s *State) MostPlayedTeammates(n int) []*Teammate {
+	teammates := make([]*Teammate, 0, len(s.Teammates))
+	for _, t := range s.Teammates {
+		teammates = append(teammates, t)
+	}
+
+	sort.Slice(teammates, func(i, j int) bool {
+		if teammates[i].Games != teammates[j].Games {
+			return teammates[i].Games > teammates[j].Games
+		}
+
+		return teammates[i].Puuid < teammates[j].Puuid
+	})
+
+	if n >= 0 && n < len(teammates) {
+		teammates = teammates[:n]
+	}
+
+	return teammates
+}
+
+// Update fetches the most recent matches of the player that are not in the state yet, drops the older ones,
+// and recomputes the aggregates.
+// The client's context is used, with the priority of the filters and a cache. If state is nil, a new state is created.
+// Matches that failed to be fetched are left out of the state, so the next update retries them.
+func Update(client apiclient.Client, continent continent.Continent, puuid string, filters *Filters, state *State) (*State, error) {
+	if filters == nil {
+		filters = &Filters{}
+	}
+
+	if state == nil {
+		state = NewState(puuid)
+	} else if state.Puuid != puuid {
+		return state, fmt.Errorf("state belongs to %s, not %s", state.Puuid, puuid)
+	}
+
+	state.init()
+
+	priority := defaultPriority
+	if filters.Priority != nil {
+		priority = *filters.Priority
+	}
+
+	client = client.WithPriority(priority).WithCache(fetchCacheDuration)
+
+	recent, err := recentMatchIDs(client, continent, puuid, filters)
+	if err != nil {
+		return state, err
+	}
+
+	// Drop the games that are no longer among the most recent ones
+	inWindow := make(map[apiclient.MatchID]bool, len(recent))
+	for _, matchID := range recent {
+		inWindow[matchID] = true
+	}
+
+	known := make(map[apiclient.MatchID]bool, len(state.Games))
+	games := state.Games[:0]
+	for _, game := range state.Games {
+		if inWindow[game.MatchID] {
+			games = append(games, game)
+			known[game.MatchID] = true
+		}
+	}
+
+	var matchIDs []apiclient.MatchID
+	for _, matchID := range recent {
+		if !known[matchID] {
+			matchIDs = append(matchIDs, matchID)
+		}
+	}
+
+	matches, errs := fetchMatches(client, matchIDs, filters.Concurrency)
+	for _, match := range matches {
+		if match != nil {
+			games = append(games, newGame(match, puuid))
+		}
+	}
+
+	sort.SliceStable(games, func(i, j int) bool {
+		return games[i].GameCreation.After(games[j].GameCreation)
+	})
+
+	state.Games = games
+	state.aggregate(filters.ExcludeAbnormal)
+
+	for _, err := range errs {
+		if err != nil {
+			return state, err
+		}
+	}
+
+	return state, nil
+}
+
+// recentMatchIDs returns the IDs of the most recent matches of the player, newest first.
+func recentMatchIDs(client apiclient.Client, continent continent.Continent, puuid string, filters *Filters) ([]apiclient.MatchID, error) {
+	count := filters.Count
+	if count <= 0 {
+		count = defaultCount
+	}
+
+	// The iterator caps the page size at the maximum of the API
+	pageSize := int16(count)
+	if count > math.MaxInt16 {
+		pageSize = math.MaxInt16
+	}
+
+	it := client.GetMatchlistIterator(continent, puuid, &apiclient.GetMatchlistOptions{
+		Queue:     filters.Queue,
+		StartTime: filters.StartTime,
+		Count:     &pageSize,
+	})
+
+	var matchIDs []apiclient.MatchID
+	for len(matchIDs) < count && it.Next() {
+		matchIDs = append(matchIDs, it.MatchID())
+	}
+
+	if err := it.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get matchlist: %w", err)
+	}
+
+	return matchIDs, nil
+}
+
+func fetchMatches(client apiclient.Client, matchIDs []apiclient.MatchID, concurrency int) ([]*apiclient.Match, []error) {
+	if concurrency <= 0 {
+		concurrency = defaultConcurrency
+	}
+
+	matches := make([]*apiclient.Match, len(matchIDs))
+	errs := make([]error, len(matchIDs))
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				match, err := client.GetMatchByID(matchIDs[i])
+				if err != nil {
+					errs[i] = fmt.Errorf("failed to get match %s: %w", matchIDs[i], err)
+					continue
+				}
+
+				matches[i] = match
+			}
+		}()
+	}
+
+	for i := range matchIDs {
+		indexes <- i
+	}
+
+	close(indexes)
+	wg.Wait()
+
+	return matches, errs
+}
+
+func newGame(match *apiclient.Match, puuid string) *Game {
+	game := &Game{
+		MatchID:      match.Metadata.MatchID,
+		QueueID:      match.Info.QueueID,
+		GameCreation: time.UnixMilli(match.Info.GameCreation),
+		Normal:       game_status.Classify(match).Normal(),
+	}
+
+	var player *apiclient.MatchInfoParticipant
+	for i := range match.Info.Participants {
+		if match.Info.Participants[i].SummonerPuuid == puuid {
+			player = &match.Info.Participants[i]
+			break
+		}
+	}
+
+	if player == nil {
+		return game
+	}
+
+	game.Played = true
+	game.ChampionName = player.ChampionName
+	game.TeamPosition = player.TeamPosition
+	game.Win = player.Win
+	game.Kills = player.Kills
+	game.Deaths = player.Deaths
+	game.Assists = player.Assists
+
+	for _, participant := range match.Info.Participants {
+		if participant.TeamID != player.TeamID || participant.SummonerPuuid == puuid {
+			continue
+		}
+
+		game.Teammates = append(game.Teammates, GameTeammate{
+			Puuid:    participant.SummonerPuuid,
+			GameName: participant.RiotIdGameName,
+			TagLine:  participant.RiotIdTagline,
+		})
+	}
+
+	return game
+}
+
+// aggregate computes the aggregates from the games of the state.
+func (s *State) aggregate(excludeAbnormal bool) {
+	s.Total = Record{}
+	s.Champions = make(map[string]*Record)
+	s.Positions = make(map[string]*Record)
+	s.Queues = make(map[queue.ID]*Record)
+	s.Teammates = make(map[string]*Teammate)
+	s.RecentRanked = nil
+
+	// Oldest first, so that teammates keep their latest Riot ID
+	for i := len(s.Games) - 1; i >= 0; i-- {
+		game := s.Games[i]
+		if !game.Played || excludeAbnormal && !game.Normal {
+			continue
+		}
+
+		s.Total.add(game)
+		addTo(s.Champions, game.ChampionName, game)
+		if game.TeamPosition != "" {
+			addTo(s.Positions, game.TeamPosition, game)
+		}
+		addTo(s.Queues, game.QueueID, game)
+
+		for _, teammate := range game.Teammates {
+			t, ok := s.Teammates[teammate.Puuid]
+			if !ok {
+				t = &Teammate{Puuid: teammate.Puuid}
+				s.Teammates[teammate.Puuid] = t
+			}
+
+			t.GameName = teammate.GameName
+			t.TagLine = teammate.TagLine
+			t.Games++
+			if game.Win {
+				t.Wins++
+			}
+		}
+
+		if game.QueueID == queue.RankedSolo5x5 || game.QueueID == queue.RankedFlexSR {
+			s.addRankedGame(RankedGame{
+				MatchID:      game.MatchID,
+				QueueID:      game.QueueID,
+				GameCreation: game.GameCreation,
+				ChampionName: game.ChampionName,
+				Win:          game.Win,
+				Kills:        game.Kills,
+				Deaths:       game.Deaths,
+				Assists:      game.Assists,
+			})
+		}
+	}
+}
+
+func (s *State) addRankedGame(game RankedGame) {
+	s.RecentRanked = append(s.RecentRanked, game)
+	sort.SliceStable(s.RecentRanked, func(i, j int) bool {
+		return s.RecentRanked[i].GameCreation.After(s.RecentRanked[j].GameCreation)
+	})
+
+	if len(s.RecentRanked) > recentRankedLimit {
+		s.RecentRanked = s.RecentRanked[:recentRankedLimit]
+	}
+}
+
+func addTo[K comparable](records map[K]*Record, key K, game *Game) {
+	r, ok := records[key]
+	if !ok {
+		r = &Record{}
+		records[key] = r
+	}
+
+	r.add(game)
+}
@@ -0,0 +1,182 @@
+package history
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Kinveil/Riot-API-Golang/apiclient"
+	"github.com/Kinveil/Riot-API-Golang/constants/continent"
+	"github.com/Kinveil/Riot-API-Golang/constants/queue"
+	"github.com/stretchr/testify/assert"
+)
+
+func testMatch(matchID apiclient.MatchID, queueID queue.ID, gameCreation int64, champion string, win bool, teammate string) *apiclient.Match {
+	return &apiclient.Match{
+		Metadata: apiclient.MatchMetadata{MatchID: matchID},
+		Info: apiclient.MatchInfo{
+			QueueID:          queueID,
+			GameCreation:     gameCreation,
+			GameDuration:     1800,
+			GameEndTimestamp: gameCreation + 1800000,
+			GameType:         "MATCHED_GAME",
+			Participants: []apiclient.MatchInfoParticipant{
+				{SummonerPuuid: "me", TeamID: 100, ChampionName: champion, TeamPosition: "MIDDLE", Win: win, Kills: 5, Deaths: 2, Assists: 3, TimePlayed: 1800, Item0: 1055},
+				{SummonerPuuid: teammate, TeamID: 100, Win: win, TimePlayed: 1800, Item0: 1055},
+				{SummonerPuuid: "enemy", TeamID: 200, Win: !win, TimePlayed: 1800, Item0: 1055},
+			},
+		},
+	}
+}
+
+func TestAggregate(t *testing.T) {
+	abnormal := testMatch("NA1_4", queue.RankedSolo5x5, 4000, "Ahri", true, "duo")
+	abnormal.Info.GameDuration = 180
+
+	state := NewState("me")
+	for _, match := range []*apiclient.Match{
+		abnormal,
+		testMatch("NA1_3", queue.ARAM, 3000, "Lux", true, "random"),
+		testMatch("NA1_2", queue.RankedSolo5x5, 2000, "Ahri", false, "duo"),
+		testMatch("NA1_1", queue.RankedSolo5x5, 1000, "Ahri", true, "duo"),
+	} {
+		state.Games = append(state.Games, newGame(match, "me"))
+	}
+	state.aggregate(true)
+
+	assert.False(t, state.Games[0].Normal)
+	assert.Equal(t, Record{Games: 3, Wins: 2, Kills: 15, Deaths: 6, Assists: 9}, state.Total)
+	assert.Equal(t, 0.5, state.Champions["Ahri"].Winrate())
+	assert.Equal(t, float64(4), state.Champions["Ahri"].KDA())
+	assert.Equal(t, 3, state.Positions["MIDDLE"].Games)
+	assert.Equal(t, 1, state.Queues[queue.ARAM].Games)
+
+	teammates := state.MostPlayedTeammates(1)
+	if assert.Len(t, teammates, 1) {
+		assert.Equal(t, &Teammate{Puuid: "duo", Games: 2, Wins: 1}, teammates[0])
+	}
+	assert.Len(t, state.MostPlayedTeammates(-1), 2)
+
+	if assert.Len(t, state.RecentRanked, 2) {
+		assert.Equal(t, apiclient.MatchID("NA1_2"), state.RecentRanked[0].MatchID)
+	}
+
+	// The state can be stored and resumed
+	data, err := json.Marshal(state)
+	assert.NoError(t, err)
+
+	var resumed State
+	assert.NoError(t, json.Unmarshal(data, &resumed))
+	if assert.Len(t, resumed.Games, 4) {
+		assert.Equal(t, state.Games[1].Teammates, resumed.Games[1].Teammates)
+		assert.True(t, state.Games[1].GameCreation.Equal(resumed.Games[1].GameCreation))
+	}
+	assert.Equal(t, state.Queues[queue.ARAM], resumed.Queues[queue.ARAM])
+}
+
+// fakeClient serves a player whose matches are NA1_1 to NA1_<matches>, newest last.
+type fakeClient struct {
+	apiclient.Client
+	mutex     sync.Mutex
+	matches   int
+	fetched   []apiclient.MatchID
+	active    int
+	maxActive int
+	failOn    apiclient.MatchID
+	priority  int
+	pages     int
+}
+
+func (c *fakeClient) WithPriority(priority int) apiclient.Client {
+	c.priority = priority
+	return c
+}
+
+func (c *fakeClient) WithCache(duration time.Duration) apiclient.Client {
+	return c
+}
+
+func (c *fakeClient) GetMatchlistIterator(cont continent.Continent, puuid string, opts *apiclient.GetMatchlistOptions) *apiclient.MatchlistIterator {
+	return apiclient.NewMatchlistIterator(c, cont, puuid, opts)
+}
+
+func (c *fakeClient) GetMatchlist(cont continent.Continent, puuid string, opts *apiclient.GetMatchlistOptions) (*apiclient.Matchlist, error) {
+	c.pages++
+
+	var matchlist apiclient.Matchlist
+	for i := c.matches - int(*opts.Start); i > 0 && len(matchlist) < int(*opts.Count); i-- {
+		matchlist = append(matchlist, apiclient.MatchID(fmt.Sprintf("NA1_%d", i)))
+	}
+
+	return &matchlist, nil
+}
+
+func (c *fakeClient) GetMatchByID(matchID apiclient.MatchID) (*apiclient.Match, error) {
+	c.mutex.Lock()
+	c.fetched = append(c.fetched, matchID)
+	c.active++
+	if c.active > c.maxActive {
+		c.maxActive = c.active
+	}
+	c.mutex.Unlock()
+
+	// Leave time for the other workers to start
+	time.Sleep(5 * time.Millisecond)
+
+	c.mutex.Lock()
+	c.active--
+	c.mutex.Unlock()
+
+	if matchID == c.failOn {
+		return &apiclient.Match{}, fmt.Errorf("server error")
+	}
+
+	var i int64
+	fmt.Sscanf(string(matchID), "NA1_%d", &i)
+	return testMatch(matchID, queue.RankedSolo5x5, i*1000, "Ahri", i%2 == 0, "duo"), nil
+}
+
+func TestUpdate(t *testing.T) {
+	client := &fakeClient{matches: 6, failOn: "NA1_5"}
+	filters := &Filters{Count: 4, Concurrency: 2}
+
+	// The failed match is left out and retried by the next update
+	state, err := Update(client, continent.AMERICAS, "me", filters, nil)
+	assert.ErrorContains(t, err, "NA1_5")
+	assert.Len(t, client.fetched, 4)
+	assert.Equal(t, 2, client.maxActive)
+	assert.Len(t, state.Games, 3)
+	assert.Equal(t, 3, state.Total.Games)
+	assert.Equal(t, 1, client.pages)
+	assert.Equal(t, -1, client.priority)
+
+	// Resuming fetches the new and failed matches, and drops the games that are no longer among the last 4
+	client.matches, client.failOn, client.fetched = 7, "", nil
+	state, err = Update(client, continent.AMERICAS, "me", filters, state)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []apiclient.MatchID{"NA1_7", "NA1_5"}, client.fetched)
+
+	var matchIDs []apiclient.MatchID
+	for _, game := range state.Games {
+		matchIDs = append(matchIDs, game.MatchID)
+	}
+	assert.Equal(t, []apiclient.MatchID{"NA1_7", "NA1_6", "NA1_5", "NA1_4"}, matchIDs)
+	assert.Equal(t, Record{Games: 4, Wins: 2, Kills: 20, Deaths: 8, Assists: 12}, state.Total)
+
+	_, err = Update(client, continent.AMERICAS, "someone else", filters, state)
+	assert.Error(t, err)
+}
+
+func TestUpdatePagination(t *testing.T) {
+	client := &fakeClient{matches: 250}
+	priority := 0
+
+	state, err := Update(client, continent.AMERICAS, "me", &Filters{Count: 150, Priority: &priority}, nil)
+	assert.NoError(t, err)
+	assert.Len(t, state.Games, 150)
+	assert.Equal(t, apiclient.MatchID("NA1_101"), state.Games[len(state.Games)-1].MatchID)
+	assert.Equal(t, 2, client.pages)
+	assert.Equal(t, 0, client.priority)
+}
@@ -0,0 +1,199 @@
+package objectives
+
+import (
+	"time"
+
+	"github.com/Kinveil/Riot-API-Golang/apiclient"
+)
+
+type Kind string
+
+const (
+	Dragon     Kind = "DRAGON"
+	DragonSoul Kind = "DRAGON_SOUL"
+	Herald     Kind = "RIFTHERALD"
+	Baron      Kind = "BARON_NASHOR"
+	Grubs      Kind = "HORDE"
+	Tower      Kind = "TOWER"
+	Inhibitor  Kind = "INHIBITOR"
+	Plate      Kind = "TURRET_PLATE"
+)
+
+// Objective is an objective taken by a team.
+// Fields that do not apply to the objective's kind are left empty.
+type Objective struct {
+	Kind                    Kind
+	Timestamp               time.Duration
+	TeamID                  int16 // The team that took the objective
+	KillerID                int16 // 0 when the objective was taken by minions
+	AssistingParticipantIDs []int16
+	Position                apiclient.MatchTimelinePosition
+
+	DragonSubType apiclient.MatchTimelineEvent_MonsterSubType // Dragon only
+	DragonSoul    apiclient.MatchTimelineEvent_DragonSoul     // DragonSoul only
+	Lane          apiclient.MatchTimelineEvent_LaneType       // Tower, Inhibitor and Plate only
+	TowerTier     apiclient.MatchTimelineEvent_TowerType      // Tower only
+}
+
+// TeamObjectives lists the objectives taken by a team in chronological order.
+type TeamObjectives struct {
+	TeamID     int16
+	Objectives []Objective
+}
+
+// Timeline builds the objective timeline of both teams, keyed by team ID.
+func Timeline(timeline *apiclient.MatchTimeline) map[int16]*TeamObjectives {
+	teams := map[int16]*TeamObjectives{
+		100: {TeamID: 100},
+		200: {TeamID: 200},
+	}
+
+	add := func(o Objective) {
+		if _, ok := teams[o.TeamID]; !ok {
+			teams[o.TeamID] = &TeamObjectives{TeamID: o.TeamID}
+		}
+
+		teams[o.TeamID].Objectives = append(teams[o.TeamID].Objectives, o)
+	}
+
+	for _, event := range timeline.Info.Events() {
+		switch e := event.(type) {
+		case *apiclient.MatchTimelineEvent_EliteMonsterKill:
+			o := Objective{
+				Kind:                    Kind(e.MonsterType),
+				Timestamp:               toDuration(e.Timestamp),
+				TeamID:                  e.KillerTeamID,
+				KillerID:                e.KillerID,
+				AssistingParticipantIDs: e.AssistingParticipantIDs,
+				Position:                e.Position,
+			}
+
+			if e.MonsterType == apiclient.MatchTimelineEvent_MonsterType_Dragon {
+				o.DragonSubType = e.MonsterSubType
+			}
+
+			add(o)
+		case *apiclient.MatchTimelineEvent_DragonSoulGiven:
+			add(Objective{
+				Kind:       DragonSoul,
+				Timestamp:  toDuration(e.Timestamp),
+				TeamID:     e.TeamID,
+				DragonSoul: e.Name,
+			})
+		case *apiclient.MatchTimelineEvent_BuildingKill:
+			// The event's team ID is the team that owned the building
+			o := Objective{
+				Timestamp:               toDuration(e.Timestamp),
+				TeamID:                  opposingTeam(e.TeamID),
+				KillerID:                e.KillerID,
+				AssistingParticipantIDs: e.AssistingParticipantIDs,
+				Position:                e.Position,
+				Lane:                    e.LaneType,
+			}
+
+			switch e.BuildingType {
+			case apiclient.MatchTimelineEvent_BuildingType_TowerBuilding:
+				o.Kind = Tower
+				if e.TowerType != nil {
+					o.TowerTier = *e.TowerType
+				}
+			case apiclient.MatchTimelineEvent_BuildingType_InhibitorBuilding:
+				o.Kind = Inhibitor
+			default:
+				continue
+			}
+
+			add(o)
+		case *apiclient.MatchTimelineEvent_TurretPlateDestroyed:
+			// The event's team ID is the team that owned the plate
+			add(Objective{
+				Kind:      Plate,
+				Timestamp: toDuration(e.Timestamp),
+				TeamID:    opposingTeam(e.TeamID),
+				KillerID:  e.KillerID,
+				Position:  e.Position,
+				Lane:      e.LaneType,
+			})
+		}
+	}
+
+	return teams
+}
+
+// Filter returns the objectives of the given kind.
+func (t *TeamObjectives) Filter(kind Kind) []Objective {
+	var objectives []Objective
+	for _, o := range t.Objectives {
+		if o.Kind == kind {
+			objectives = append(objectives, o)
+		}
+	}
+
+	return objectives
+}
+
+// Count returns the number of objectives of the given kind.
+func (t *TeamObjectives) Count(kind Kind) int {
+	return len(t.Filter(kind))
+}
+
+// DragonsBySubType returns the number of dragons taken of each subtype.
+func (t *TeamObjectives) DragonsBySubType() map[apiclient.MatchTimelineEvent_MonsterSubType]int {
+	dragons := make(map[apiclient.MatchTimelineEvent_MonsterSubType]int)
+	for _, o := range t.Filter(Dragon) {
+		dragons[o.DragonSubType]++
+	}
+
+	return dragons
+}
+
+// TowersByLane returns the towers destroyed in each lane, keyed by tier.
+func (t *TeamObjectives) TowersByLane() map[apiclient.MatchTimelineEvent_LaneType]map[apiclient.MatchTimelineEvent_TowerType]int {
+	towers := make(map[apiclient.MatchTimelineEvent_LaneType]map[apiclient.MatchTimelineEvent_TowerType]int)
+	for _, o := range t.Filter(Tower) {
+		if _, ok := towers[o.Lane]; !ok {
+			towers[o.Lane] = make(map[apiclient.MatchTimelineEvent_TowerType]int)
+		}
+
+		towers[o.Lane][o.TowerTier]++
+	}
+
+	return towers
+}
+
+// PlatesByLane returns the number of turret plates taken in each lane.
+func (t *TeamObjectives) PlatesByLane() map[apiclient.MatchTimelineEvent_LaneType]int {
+	plates := make(map[apiclient.MatchTimelineEvent_LaneType]int)
+	for _, o := range t.Filter(Plate) {
+		plates[o.Lane]++
+	}
+
+	return plates
+}
+
+func toDuration(timestamp int32) time.Duration {
+	return time.Duration(timestamp) * time.Millisecond
+}
+
+func opposingTeam(teamID int16) int16 {
+	switch teamID {
+	case 100:
+		return 200
+	case 200:
+		return 100
+	default:
+		return 0
+	}
+}
+
+// teamOf returns the team of the participant, participants 1 to 5 are on the blue side.
+func teamOf(participantID int16) int16 {
+	switch {
+	case participantID >= 1 && participantID <= 5:
+		return 100
+	case participantID >= 6 && participantID <= 10:
+		return 200
+	default:
+		return 0
+	}
+}
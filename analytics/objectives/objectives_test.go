@@ -0,0 +1,89 @@
+package objectives
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Kinveil/Riot-API-Golang/apiclient"
+	"github.com/stretchr/testify/assert"
+)
+
+func testTimeline(events ...apiclient.MatchTimelineEvent) *apiclient.MatchTimeline {
+	return &apiclient.MatchTimeline{
+		Info: apiclient.MatchTimelineInfo{
+			Frames: []apiclient.MatchTimelineFrame{{Events: events}},
+		},
+	}
+}
+
+func TestTimeline(t *testing.T) {
+	outer := apiclient.MatchTimelineEvent_TowerType_OuterTurret
+	timeline := testTimeline(
+		&apiclient.MatchTimelineEvent_EliteMonsterKill{
+			KillerTeamID:   100,
+			KillerID:       2,
+			MonsterType:    apiclient.MatchTimelineEvent_MonsterType_Dragon,
+			MonsterSubType: apiclient.MatchTimelineEvent_MonsterSubType_FireDragon,
+			Timestamp:      300000,
+		},
+		&apiclient.MatchTimelineEvent_EliteMonsterKill{
+			KillerTeamID: 200,
+			KillerID:     7,
+			MonsterType:  apiclient.MatchTimelineEvent_MonsterType_Horde,
+			Timestamp:    360000,
+		},
+		&apiclient.MatchTimelineEvent_TurretPlateDestroyed{TeamID: 200, LaneType: apiclient.MatchTimelineEvent_LaneType_TopLane, Timestamp: 400000},
+		&apiclient.MatchTimelineEvent_BuildingKill{
+			TeamID:       200,
+			BuildingType: apiclient.MatchTimelineEvent_BuildingType_TowerBuilding,
+			LaneType:     apiclient.MatchTimelineEvent_LaneType_TopLane,
+			TowerType:    &outer,
+			Timestamp:    600000,
+		},
+	)
+
+	teams := Timeline(timeline)
+
+	blue := teams[100]
+	assert.Len(t, blue.Objectives, 3)
+	assert.Equal(t, 5*time.Minute, blue.Objectives[0].Timestamp)
+	assert.Equal(t, map[apiclient.MatchTimelineEvent_MonsterSubType]int{apiclient.MatchTimelineEvent_MonsterSubType_FireDragon: 1}, blue.DragonsBySubType())
+	assert.Equal(t, 1, blue.TowersByLane()[apiclient.MatchTimelineEvent_LaneType_TopLane][outer])
+	assert.Equal(t, 1, blue.PlatesByLane()[apiclient.MatchTimelineEvent_LaneType_TopLane])
+
+	red := teams[200]
+	assert.Equal(t, 1, red.Count(Grubs))
+	assert.Equal(t, 0, red.Count(Tower))
+}
+
+func TestTeamfights(t *testing.T) {
+	at := func(x, y int16) apiclient.MatchTimelinePosition {
+		return apiclient.MatchTimelinePosition{X: x, Y: y}
+	}
+
+	timeline := testTimeline(
+		// A solo kill in top lane
+		&apiclient.MatchTimelineEvent_ChampionKill{KillerID: 1, VictimID: 6, Position: at(2000, 12000), Timestamp: 200000},
+		// A fight at dragon
+		&apiclient.MatchTimelineEvent_ChampionKill{KillerID: 7, VictimID: 3, AssistingParticipantIDs: []int16{8}, Position: at(9800, 4400), Timestamp: 900000},
+		&apiclient.MatchTimelineEvent_ChampionKill{KillerID: 4, VictimID: 7, AssistingParticipantIDs: []int16{5}, Position: at(9900, 4300), Timestamp: 905000},
+		&apiclient.MatchTimelineEvent_ChampionKill{KillerID: 4, VictimID: 8, Position: at(10100, 4500), Timestamp: 912000},
+		// A pick at the same place, long after the fight ended
+		&apiclient.MatchTimelineEvent_ChampionKill{KillerID: 9, VictimID: 2, Position: at(9800, 4400), Timestamp: 960000},
+	)
+
+	fights := Teamfights(timeline, nil)
+	if assert.Len(t, fights, 1) {
+		fight := fights[0]
+		assert.Len(t, fight.Kills, 3)
+		assert.Equal(t, 15*time.Minute, fight.Start)
+		assert.Equal(t, 15*time.Minute+12*time.Second, fight.End)
+		assert.Equal(t, int16(100), fight.Winner())
+		assert.Equal(t, []int16{3, 4, 5, 7, 8}, fight.ParticipantIDs())
+		assert.Equal(t, &Participation{Kills: 2}, fight.Participants[4])
+		assert.Equal(t, &Participation{Kills: 1, Deaths: 1}, fight.Participants[7])
+	}
+
+	all := Teamfights(timeline, &TeamfightOptions{Window: 15 * time.Second, Radius: 3000, MinKills: 1})
+	assert.Len(t, all, 3)
+}
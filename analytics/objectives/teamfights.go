@@ -0,0 +1,139 @@
+package objectives
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/Kinveil/Riot-API-Golang/apiclient"
+)
+
+type TeamfightOptions struct {
+	Window   time.Duration // Maximum time between a kill and the previous kill of the fight
+	Radius   float64       // Maximum distance between a kill and the center of the fight
+	MinKills int           // Clusters with fewer kills are not reported
+}
+
+var DefaultTeamfightOptions = TeamfightOptions{
+	Window:   15 * time.Second,
+	Radius:   3000,
+	MinKills: 3,
+}
+
+type Participation struct {
+	Kills   int
+	Deaths  int
+	Assists int
+}
+
+type Teamfight struct {
+	Start        time.Duration
+	End          time.Duration
+	Center       apiclient.MatchTimelinePosition
+	Kills        []*apiclient.MatchTimelineEvent_ChampionKill
+	TeamKills    map[int16]int            // Kills scored by each team
+	Participants map[int16]*Participation // Keyed by participant ID
+}
+
+// Winner returns the team that scored the most kills in the fight, or 0 if it was even.
+func (t *Teamfight) Winner() int16 {
+	switch {
+	case t.TeamKills[100] > t.TeamKills[200]:
+		return 100
+	case t.TeamKills[200] > t.TeamKills[100]:
+		return 200
+	default:
+		return 0
+	}
+}
+
+// ParticipantIDs returns the IDs of the participants that killed, died or assisted in the fight, sorted.
+func (t *Teamfight) ParticipantIDs() []int16 {
+	ids := make([]int16, 0, len(t.Participants))
+	for id := range t.Participants {
+		ids = append(ids, id)
+	}
+
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// Teamfights clusters the champion kills of the timeline into teamfights.
+// A kill joins the current fight if it happened within the window of the fight's last kill and within
+// the radius of the fight's center. If opts is nil, DefaultTeamfightOptions is used.
+func Teamfights(timeline *apiclient.MatchTimeline, opts *TeamfightOptions) []*Teamfight {
+	if opts == nil {
+		opts = &DefaultTeamfightOptions
+	}
+
+	kills := apiclient.EventsOfType[*apiclient.MatchTimelineEvent_ChampionKill](timeline.Info.Events())
+	sort.SliceStable(kills, func(i, j int) bool { return kills[i].Timestamp < kills[j].Timestamp })
+
+	var fights []*Teamfight
+	var current *Teamfight
+	for _, kill := range kills {
+		timestamp := toDuration(kill.Timestamp)
+		if current == nil || timestamp-current.End > opts.Window || distance(current.Center, kill.Position) > opts.Radius {
+			current = &Teamfight{
+				Start:        timestamp,
+				TeamKills:    make(map[int16]int),
+				Participants: make(map[int16]*Participation),
+			}
+			fights = append(fights, current)
+		}
+
+		current.add(kill)
+	}
+
+	var teamfights []*Teamfight
+	for _, fight := range fights {
+		if len(fight.Kills) >= opts.MinKills {
+			teamfights = append(teamfights, fight)
+		}
+	}
+
+	return teamfights
+}
+
+func (t *Teamfight) add(kill *apiclient.MatchTimelineEvent_ChampionKill) {
+	t.Kills = append(t.Kills, kill)
+	t.End = toDuration(kill.Timestamp)
+
+	var x, y float64
+	for _, k := range t.Kills {
+		x += float64(k.Position.X)
+		y += float64(k.Position.Y)
+	}
+
+	t.Center = apiclient.MatchTimelinePosition{
+		X: int16(x / float64(len(t.Kills))),
+		Y: int16(y / float64(len(t.Kills))),
+	}
+
+	// Executions have a killer ID of 0 and are credited to the team of the victim's opponents
+	if team := opposingTeam(teamOf(kill.VictimID)); team != 0 {
+		t.TeamKills[team]++
+	}
+
+	if kill.KillerID != 0 {
+		t.participant(kill.KillerID).Kills++
+	}
+
+	t.participant(kill.VictimID).Deaths++
+
+	for _, id := range kill.AssistingParticipantIDs {
+		t.participant(id).Assists++
+	}
+}
+
+func (t *Teamfight) participant(participantID int16) *Participation {
+	if _, ok := t.Participants[participantID]; !ok {
+		t.Participants[participantID] = &Participation{}
+	}
+
+	return t.Participants[participantID]
+}
+
+func distance(a, b apiclient.MatchTimelinePosition) float64 {
+	return math.Hypot(float64(a.X)-float64(b.X), float64(a.Y)-float64(b.Y))
+}
@@ -0,0 +1,161 @@
+package perks
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Kinveil/Riot-API-Golang/apiclient"
+	"github.com/Kinveil/Riot-API-Golang/staticdata"
+)
+
+// Perks are the runes and stat shards of a participant, resolved from their IDs.
+type Perks struct {
+	Keystone       staticdata.Rune
+	PrimaryTree    staticdata.RuneTree
+	SecondaryTree  staticdata.RuneTree
+	PrimaryRunes   []PerkRune // Includes the keystone
+	SecondaryRunes []PerkRune
+	Shards         []staticdata.StatShard // Offense, flex and defense
+}
+
+type PerkRune struct {
+	staticdata.Rune
+	Stats []PerkStat // Only set for match perks
+}
+
+// PerkStat is an end of game value of a rune, ex: the damage dealt by Electrocute.
+type PerkStat struct {
+	Description string // ex: Total Damage: @eogvar1@
+	Value       int32
+	Text        string // The description with the value, ex: Total Damage: 1234
+}
+
+// ResolveMatch resolves the perks of a match participant.
+// Stat shards of older matches are 0 and are skipped.
+func ResolveMatch(perks apiclient.MatchInfoParticipantPerks, runes *staticdata.RuneTrees) (*Perks, error) {
+	resolved := &Perks{}
+
+	for _, style := range perks.Styles {
+		tree, err := runes.Tree(int(style.Style))
+		if err != nil {
+			return nil, err
+		}
+
+		var selected []PerkRune
+		for _, selection := range style.Selections {
+			r, _, err := runes.Rune(int(selection.Perk))
+			if err != nil {
+				return nil, err
+			}
+
+			selected = append(selected, PerkRune{
+				Rune:  r,
+				Stats: perkStats(r, selection.Var1, selection.Var2, selection.Var3),
+			})
+		}
+
+		switch style.Description {
+		case "primaryStyle":
+			resolved.PrimaryTree = tree
+			resolved.PrimaryRunes = selected
+		case "subStyle":
+			resolved.SecondaryTree = tree
+			resolved.SecondaryRunes = selected
+		}
+	}
+
+	for _, id := range []int16{perks.StatPerks.Offense, perks.StatPerks.Flex, perks.StatPerks.Defense} {
+		if id == 0 {
+			continue
+		}
+
+		shard, err := runes.Shard(int(id))
+		if err != nil {
+			return nil, err
+		}
+
+		resolved.Shards = append(resolved.Shards, shard)
+	}
+
+	resolved.setKeystone()
+	return resolved, nil
+}
+
+// ResolveSpectator resolves the perks of a participant of an active game.
+func ResolveSpectator(perks apiclient.Perks, runes *staticdata.RuneTrees) (*Perks, error) {
+	resolved := &Perks{}
+
+	var err error
+	if resolved.PrimaryTree, err = runes.Tree(int(perks.PerkStyle)); err != nil {
+		return nil, err
+	}
+
+	if resolved.SecondaryTree, err = runes.Tree(int(perks.PerkSubStyle)); err != nil {
+		return nil, err
+	}
+
+	// The perk IDs hold the primary runes, then the secondary runes, then the stat shards
+	for _, id := range perks.PerkIDs {
+		if shard, err := runes.Shard(int(id)); err == nil {
+			resolved.Shards = append(resolved.Shards, shard)
+			continue
+		}
+
+		r, tree, err := runes.Rune(int(id))
+		if err != nil {
+			return nil, err
+		}
+
+		switch tree.ID {
+		case resolved.PrimaryTree.ID:
+			resolved.PrimaryRunes = append(resolved.PrimaryRunes, PerkRune{Rune: r})
+		case resolved.SecondaryTree.ID:
+			resolved.SecondaryRunes = append(resolved.SecondaryRunes, PerkRune{Rune: r})
+		default:
+			return nil, fmt.Errorf("rune %d is not in the selected trees", id)
+		}
+	}
+
+	resolved.setKeystone()
+	return resolved, nil
+}
+
+// setKeystone sets the keystone, the rune of the first slot of the primary tree.
+func (p *Perks) setKeystone() {
+	if len(p.PrimaryTree.Slots) == 0 {
+		return
+	}
+
+	for _, r := range p.PrimaryRunes {
+		for _, keystone := range p.PrimaryTree.Slots[0].Runes {
+			if r.ID == keystone.ID {
+				p.Keystone = r.Rune
+				return
+			}
+		}
+	}
+}
+
+func perkStats(r staticdata.Rune, values ...int32) []PerkStat {
+	var stats []PerkStat
+	for i, description := range r.EndOfGameStatDescs {
+		if i >= len(values) {
+			break
+		}
+
+		// A description can show the other values as well, ex: "Healing: @eogvar1@ (@eogvar2@ overheal)"
+		text := description
+		for j, value := range values {
+			text = strings.ReplaceAll(text, fmt.Sprintf("@eogvar%d@", j+1), strconv.Itoa(int(value)))
+		}
+
+		stats = append(stats, PerkStat{
+			Description: description,
+			Value:       values[i],
+			Text:        text,
+		})
+	}
+
+	return stats
+}
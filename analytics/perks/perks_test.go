@@ -0,0 +1,100 @@
+package perks
+
+import (
+	"testing"
+
+	"github.com/Kinveil/Riot-API-Golang/apiclient"
+	"github.com/Kinveil/Riot-API-Golang/staticdata"
+	"github.com/stretchr/testify/assert"
+)
+
+func testRuneTrees() *staticdata.RuneTrees {
+	return &staticdata.RuneTrees{Trees: []staticdata.RuneTree{
+		{
+			ID:   8100,
+			Name: "Domination",
+			Slots: []staticdata.RuneSlot{
+				{Runes: []staticdata.Rune{{ID: 8112, Name: "Electrocute", EndOfGameStatDescs: []string{"Total Damage Dealt: @eogvar1@"}}}},
+				{Runes: []staticdata.Rune{{ID: 8143, Name: "Sudden Impact"}}},
+			},
+		},
+		{
+			ID:   8000,
+			Name: "Precision",
+			Slots: []staticdata.RuneSlot{
+				{Runes: []staticdata.Rune{{ID: 8010, Name: "Conqueror"}}},
+				{Runes: []staticdata.Rune{{ID: 9111, Name: "Triumph", EndOfGameStatDescs: []string{"Total health restored: @eogvar1@", "Bonus gold granted: @eogvar2@"}}}},
+			},
+		},
+	}, Shards: []staticdata.StatShard{
+		{ID: 5001, Name: "Health Scaling"},
+		{ID: 5005, Name: "Attack Speed"},
+		{ID: 5008, Name: "Adaptive Force"},
+		{ID: 5011, Name: "Health"},
+	}}
+}
+
+func TestResolveMatch(t *testing.T) {
+	perks := apiclient.MatchInfoParticipantPerks{
+		StatPerks: apiclient.MatchInfoParticipantPerksStatPerks{Offense: 5008, Flex: 5008, Defense: 5011},
+		Styles: []apiclient.MatchInfoParticipantPerksStyles{
+			{
+				Description: "primaryStyle",
+				Style:       8100,
+				Selections: []apiclient.MatchInfoParticipantPerksStylesSelection{
+					{Perk: 8112, Var1: 1234},
+					{Perk: 8143, Var1: 250},
+				},
+			},
+			{
+				Description: "subStyle",
+				Style:       8000,
+				Selections:  []apiclient.MatchInfoParticipantPerksStylesSelection{{Perk: 9111, Var1: 800, Var2: 120}},
+			},
+		},
+	}
+
+	resolved, err := ResolveMatch(perks, testRuneTrees())
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, "Electrocute", resolved.Keystone.Name)
+	assert.Equal(t, "Domination", resolved.PrimaryTree.Name)
+	assert.Equal(t, "Precision", resolved.SecondaryTree.Name)
+	assert.Len(t, resolved.PrimaryRunes, 2)
+	assert.Equal(t, "Total Damage Dealt: 1234", resolved.PrimaryRunes[0].Stats[0].Text)
+	assert.Empty(t, resolved.PrimaryRunes[1].Stats)
+	assert.Equal(t, []PerkStat{
+		{Description: "Total health restored: @eogvar1@", Value: 800, Text: "Total health restored: 800"},
+		{Description: "Bonus gold granted: @eogvar2@", Value: 120, Text: "Bonus gold granted: 120"},
+	}, resolved.SecondaryRunes[0].Stats)
+	assert.Equal(t, []staticdata.StatShard{{ID: 5008, Name: "Adaptive Force"}, {ID: 5008, Name: "Adaptive Force"}, {ID: 5011, Name: "Health"}}, resolved.Shards)
+
+	// Older matches have no stat shards
+	perks.StatPerks = apiclient.MatchInfoParticipantPerksStatPerks{}
+	resolved, err = ResolveMatch(perks, testRuneTrees())
+	if assert.NoError(t, err) {
+		assert.Empty(t, resolved.Shards)
+	}
+
+	perks.Styles[0].Selections[0].Perk = 1
+	_, err = ResolveMatch(perks, testRuneTrees())
+	assert.Error(t, err)
+}
+
+func TestResolveSpectator(t *testing.T) {
+	perks := apiclient.Perks{
+		PerkIDs:      []int16{8010, 9111, 8143, 5005, 5008, 5001},
+		PerkStyle:    8000,
+		PerkSubStyle: 8100,
+	}
+
+	resolved, err := ResolveSpectator(perks, testRuneTrees())
+	if assert.NoError(t, err) {
+		assert.Equal(t, "Conqueror", resolved.Keystone.Name)
+		assert.Len(t, resolved.PrimaryRunes, 2)
+		assert.Len(t, resolved.SecondaryRunes, 1)
+		assert.Equal(t, "Attack Speed", resolved.Shards[0].Name)
+	}
+}
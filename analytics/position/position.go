@@ -0,0 +1,322 @@
+package position
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/Kinveil/Riot-API-Golang/analytics/heatmap"
+	"github.com/Kinveil/Riot-API-Golang/apiclient"
+	"github.com/Kinveil/Riot-API-Golang/constants/summoner_spell"
+	"github.com/Kinveil/Riot-API-Golang/staticdata"
+)
+
+type Position string
+
+const (
+	Top     Position = "TOP"
+	Jungle  Position = "JUNGLE"
+	Middle  Position = "MIDDLE"
+	Bottom  Position = "BOTTOM"
+	Utility Position = "UTILITY"
+)
+
+// Positions lists every Position in the order of the team composition screen.
+var Positions = []Position{Top, Jungle, Middle, Bottom, Utility}
+
+// Weights of each piece of evidence.
+const (
+	teamPositionWeight       = 3.0
+	individualPositionWeight = 2.0
+	laneRoleWeight           = 1.0
+	smiteWeight              = 4.0
+	noSmitePenalty           = -2.0
+	timelineWeight           = 3.0
+	lowCSWeight              = 1.5
+	highCSPenalty            = -1.0
+)
+
+const (
+	// Frames between these times are used to see where participants played
+	earlyGameStart = 2 * time.Minute
+	earlyGameEnd   = 10 * time.Minute
+	// Lane minions killed per minute below which a participant is considered a support
+	supportCSPerMinute = 2.5
+)
+
+// Evidence given by the primary tag of the champion.
+var championTagScores = map[string]map[Position]float64{
+	"Support":  {Utility: 1},
+	"Marksman": {Bottom: 1},
+	"Mage":     {Middle: 0.5, Utility: 0.25},
+	"Assassin": {Middle: 0.5, Jungle: 0.25},
+	"Fighter":  {Top: 0.5, Jungle: 0.25},
+	"Tank":     {Top: 0.5, Jungle: 0.25, Utility: 0.25},
+}
+
+type Assignment struct {
+	ParticipantID int16
+	Puuid         string
+	TeamID        int16
+	Position      Position
+	Confidence    float64              // Between 0 and 1
+	Scores        map[Position]float64 // The evidence gathered for each position
+}
+
+// Infer assigns a canonical position to every participant of a Summoner's Rift match, keyed by participant ID.
+// The timeline and champions are optional, they improve the inference when the match fields are missing or contradictory.
+// When a team has five players, each position is assigned to exactly one of them.
+func Infer(match *apiclient.Match, timeline *apiclient.MatchTimeline, champions staticdata.Champions) (map[int16]*Assignment, error) {
+	if match.Info.MapID != heatmap.SummonersRift.ID {
+		return nil, fmt.Errorf("position inference is not supported on map %d", match.Info.MapID)
+	}
+
+	var early map[int16]*earlyGame
+	if timeline != nil {
+		early = earlyGames(timeline)
+	}
+
+	assignments := make(map[int16]*Assignment)
+	teams := make(map[int16][]*Assignment)
+	for _, participant := range match.Info.Participants {
+		a := &Assignment{
+			ParticipantID: participant.ParticipantID,
+			Puuid:         participant.SummonerPuuid,
+			TeamID:        participant.TeamID,
+			Scores:        make(map[Position]float64),
+		}
+
+		a.scoreFields(participant)
+		a.scoreSmite(participant)
+		a.scoreChampion(participant, champions)
+
+		if e, ok := early[participant.ParticipantID]; ok {
+			a.scoreEarlyGame(e)
+		} else {
+			a.scoreEndOfGameCS(participant, match.Info.Duration())
+		}
+
+		assignments[a.ParticipantID] = a
+		teams[a.TeamID] = append(teams[a.TeamID], a)
+	}
+
+	for _, team := range teams {
+		if len(team) == len(Positions) {
+			assignTeam(team)
+		} else {
+			for _, a := range team {
+				a.Position = a.best()
+			}
+		}
+
+		for _, a := range team {
+			a.Confidence = a.confidence()
+		}
+	}
+
+	return assignments, nil
+}
+
+func (a *Assignment) scoreFields(participant apiclient.MatchInfoParticipant) {
+	if p, ok := parse(participant.TeamPosition); ok {
+		a.Scores[p] += teamPositionWeight
+	}
+
+	if p, ok := parse(participant.IndividualPosition); ok {
+		a.Scores[p] += individualPositionWeight
+	}
+
+	var p Position
+	switch participant.Lane {
+	case "TOP":
+		p = Top
+	case "JUNGLE":
+		p = Jungle
+	case "MIDDLE", "MID":
+		p = Middle
+	case "BOTTOM", "BOT":
+		switch participant.Role {
+		case "CARRY", "DUO_CARRY":
+			p = Bottom
+		case "SUPPORT", "DUO_SUPPORT":
+			p = Utility
+		}
+	}
+
+	if p != "" {
+		a.Scores[p] += laneRoleWeight
+	}
+}
+
+func (a *Assignment) scoreSmite(participant apiclient.MatchInfoParticipant) {
+	if participant.Summoner1ID == summoner_spell.SummonerSmite || participant.Summoner2ID == summoner_spell.SummonerSmite {
+		a.Scores[Jungle] += smiteWeight
+	} else {
+		a.Scores[Jungle] += noSmitePenalty
+	}
+}
+
+func (a *Assignment) scoreChampion(participant apiclient.MatchInfoParticipant, champions staticdata.Champions) {
+	key := strconv.Itoa(int(participant.ChampionID))
+	for _, champion := range champions {
+		if champion.Key != key || len(champion.Tags) == 0 {
+			continue
+		}
+
+		for p, score := range championTagScores[champion.Tags[0]] {
+			a.Scores[p] += score
+		}
+
+		return
+	}
+}
+
+func (a *Assignment) scoreEarlyGame(e *earlyGame) {
+	if e.frames > 0 {
+		for p, count := range e.positions {
+			a.Scores[p] += timelineWeight * float64(count) / float64(e.frames)
+		}
+	}
+
+	if e.minutes > 0 {
+		a.scoreLaneCS(float64(e.minionsKilled) / e.minutes)
+	}
+}
+
+func (a *Assignment) scoreEndOfGameCS(participant apiclient.MatchInfoParticipant, gameDuration time.Duration) {
+	if gameDuration <= 0 {
+		return
+	}
+
+	a.scoreLaneCS(float64(participant.TotalMinionsKilled) / gameDuration.Minutes())
+}
+
+// scoreLaneCS tells supports apart from the other laners, as they leave the lane minions to their carry.
+func (a *Assignment) scoreLaneCS(perMinute float64) {
+	if perMinute < supportCSPerMinute {
+		a.Scores[Utility] += lowCSWeight
+	} else {
+		a.Scores[Utility] += highCSPenalty
+	}
+}
+
+func (a *Assignment) best() Position {
+	best := Positions[0]
+	for _, p := range Positions[1:] {
+		if a.Scores[p] > a.Scores[best] {
+			best = p
+		}
+	}
+
+	return best
+}
+
+// confidence is the share of the positive evidence that supports the assigned position.
+func (a *Assignment) confidence() float64 {
+	var total float64
+	for _, p := range Positions {
+		if a.Scores[p] > 0 {
+			total += a.Scores[p]
+		}
+	}
+
+	if total == 0 || a.Scores[a.Position] <= 0 {
+		return 0
+	}
+
+	return a.Scores[a.Position] / total
+}
+
+// assignTeam gives each player of a five player team a different position, maximizing the total score.
+func assignTeam(team []*Assignment) {
+	best := -1.0
+	var bestOrder []int
+
+	order := make([]int, len(Positions))
+	used := make([]bool, len(Positions))
+
+	var permute func(i int, score float64)
+	permute = func(i int, score float64) {
+		if i == len(team) {
+			if bestOrder == nil || score > best {
+				best = score
+				bestOrder = append([]int(nil), order...)
+			}
+			return
+		}
+
+		for p := range Positions {
+			if used[p] {
+				continue
+			}
+
+			used[p] = true
+			order[i] = p
+			permute(i+1, score+team[i].Scores[Positions[p]])
+			used[p] = false
+		}
+	}
+
+	permute(0, 0)
+
+	for i, a := range team {
+		a.Position = Positions[bestOrder[i]]
+	}
+}
+
+func parse(s string) (Position, bool) {
+	for _, p := range Positions {
+		if string(p) == s {
+			return p, true
+		}
+	}
+
+	return "", false
+}
+
+type earlyGame struct {
+	frames        int
+	positions     map[Position]int
+	minionsKilled int32
+	minutes       float64 // Game time of the last early frame
+}
+
+func earlyGames(timeline *apiclient.MatchTimeline) map[int16]*earlyGame {
+	games := make(map[int16]*earlyGame)
+
+	for _, frame := range timeline.Info.Frames {
+		timestamp := time.Duration(frame.Timestamp) * time.Millisecond
+		if timestamp < earlyGameStart || timestamp > earlyGameEnd {
+			continue
+		}
+
+		for _, pf := range frame.ParticipantFrames {
+			e, ok := games[pf.ParticipantID]
+			if !ok {
+				e = &earlyGame{positions: make(map[Position]int)}
+				games[pf.ParticipantID] = e
+			}
+
+			e.minionsKilled = pf.MinionsKilled
+			e.minutes = timestamp.Minutes()
+			if heatmap.InBase(pf.Position) {
+				continue
+			}
+
+			e.frames++
+			switch heatmap.LaneAt(pf.Position) {
+			case apiclient.MatchTimelineEvent_LaneType_TopLane:
+				e.positions[Top]++
+			case apiclient.MatchTimelineEvent_LaneType_MidLane:
+				e.positions[Middle]++
+			case apiclient.MatchTimelineEvent_LaneType_BotLane:
+				e.positions[Bottom]++
+				e.positions[Utility]++
+			default:
+				e.positions[Jungle]++
+			}
+		}
+	}
+
+	return games
+}
@@ -0,0 +1,77 @@
+package position
+
+import (
+	"testing"
+
+	"github.com/Kinveil/Riot-API-Golang/apiclient"
+	"github.com/Kinveil/Riot-API-Golang/constants/summoner_spell"
+	"github.com/Kinveil/Riot-API-Golang/staticdata"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInfer(t *testing.T) {
+	participant := func(id int16, championID int32, teamPosition string, smite bool, cs int32) apiclient.MatchInfoParticipant {
+		p := apiclient.MatchInfoParticipant{
+			ParticipantID:      id,
+			TeamID:             100,
+			ChampionID:         championID,
+			TeamPosition:       teamPosition,
+			IndividualPosition: "Invalid",
+			Summoner1ID:        summoner_spell.SummonerFlash,
+			Summoner2ID:        summoner_spell.SummonerDot,
+			TotalMinionsKilled: cs,
+		}
+
+		if smite {
+			p.Summoner2ID = summoner_spell.SummonerSmite
+		}
+
+		return p
+	}
+
+	match := &apiclient.Match{
+		Info: apiclient.MatchInfo{
+			MapID:            11,
+			GameDuration:     1800,
+			GameEndTimestamp: 1700001800000,
+			Participants: []apiclient.MatchInfoParticipant{
+				participant(1, 1, "", false, 250),  // Annie
+				participant(2, 2, "", false, 20),   // Olaf, no smite, support CS
+				participant(3, 3, "", true, 40),    // Galio with smite
+				participant(4, 4, "", false, 230),  // Twisted Fate
+				participant(5, 22, "", false, 260), // Ashe
+			},
+		},
+	}
+
+	champions := staticdata.Champions{
+		{Key: "1", Tags: []string{"Mage"}},
+		{Key: "2", Tags: []string{"Fighter"}},
+		{Key: "3", Tags: []string{"Tank"}},
+		{Key: "4", Tags: []string{"Mage"}},
+		{Key: "22", Tags: []string{"Marksman"}},
+	}
+
+	// Annie and Twisted Fate both look like mid laners, the match fields settle it
+	match.Info.Participants[0].IndividualPosition = "TOP"
+	match.Info.Participants[3].TeamPosition = "MIDDLE"
+
+	assignments, err := Infer(match, nil, champions)
+	if assert.NoError(t, err) {
+		assert.Equal(t, Top, assignments[1].Position)
+		assert.Equal(t, Utility, assignments[2].Position)
+		assert.Equal(t, Jungle, assignments[3].Position)
+		assert.Equal(t, Middle, assignments[4].Position)
+		assert.Equal(t, Bottom, assignments[5].Position)
+
+		assert.Greater(t, assignments[4].Confidence, assignments[1].Confidence)
+		for _, a := range assignments {
+			assert.GreaterOrEqual(t, a.Confidence, 0.0)
+			assert.LessOrEqual(t, a.Confidence, 1.0)
+		}
+	}
+
+	match.Info.MapID = 12
+	_, err = Infer(match, nil, champions)
+	assert.Error(t, err)
+}
@@ -0,0 +1,235 @@
+package scoreboard
+
+import (
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/Kinveil/Riot-API-Golang/apiclient"
+	"github.com/Kinveil/Riot-API-Golang/constants/patch"
+	"github.com/Kinveil/Riot-API-Golang/constants/queue"
+	"github.com/Kinveil/Riot-API-Golang/constants/summoner_spell"
+	"github.com/Kinveil/Riot-API-Golang/staticdata"
+)
+
+// Lookups resolve IDs to names, every field is optional.
+type Lookups struct {
+	Items          *staticdata.Items
+	SummonerSpells staticdata.SummonerSpells
+}
+
+type Item struct {
+	ID   int32
+	Name string // Empty if the items lookup is missing
+}
+
+type SummonerSpell struct {
+	ID   summoner_spell.ID
+	Name string // Empty if the summoner spells lookup is missing
+}
+
+type ParticipantSummary struct {
+	ParticipantID  int16
+	Puuid          string
+	GameName       string
+	TagLine        string
+	ChampionID     int32
+	ChampionName   string
+	TeamID         int16
+	Position       string
+	Win            bool
+	Level          int16
+	Items          [7]Item // Slots 0 to 5, then the trinket
+	SummonerSpells [2]SummonerSpell
+
+	Kills             int16
+	Deaths            int16
+	Assists           int16
+	KDA               float64 // (Kills + Assists) / Deaths, with at least one death
+	KillParticipation float64 // Share of the team's kills the participant killed or assisted, between 0 and 1
+
+	CS          int32 // Lane and jungle minions
+	CSPerMinute float64
+
+	Gold      int32
+	GoldShare float64 // Share of the team's gold, between 0 and 1
+
+	DamageToChampions int32
+	DamageShare       float64 // Share of the team's damage to champions, between 0 and 1
+	DamageTaken       int32
+
+	VisionScore     int32
+	VisionPerMinute float64
+}
+
+type TeamSummary struct {
+	TeamID            int16
+	Win               bool
+	Kills             int16
+	Deaths            int16
+	Assists           int16
+	Gold              int32
+	DamageToChampions int32
+	CS                int32
+	VisionScore       int32
+	Objectives        apiclient.MatchInfoTeamObjectives
+	Bans              []apiclient.MatchInfoTeamBan
+	Participants      []*ParticipantSummary
+}
+
+type MatchSummary struct {
+	MatchID      apiclient.MatchID
+	QueueID      queue.ID
+	GameVersion  patch.Patch
+	Duration     time.Duration
+	Teams        []*TeamSummary // Sorted by team ID
+	Participants []*ParticipantSummary
+}
+
+// New builds the scoreboard of the match. lookups may be nil.
+func New(match *apiclient.Match, lookups *Lookups) *MatchSummary {
+	if lookups == nil {
+		lookups = &Lookups{}
+	}
+
+	summary := &MatchSummary{
+		MatchID:     match.Metadata.MatchID,
+		QueueID:     match.Info.QueueID,
+		GameVersion: match.Info.GameVersion,
+		Duration:    match.Info.Duration(),
+	}
+
+	teams := make(map[int16]*TeamSummary)
+	for _, t := range match.Info.Teams {
+		teams[t.TeamID] = &TeamSummary{
+			TeamID:     t.TeamID,
+			Win:        t.Win,
+			Objectives: t.Objectives,
+			Bans:       t.Bans,
+		}
+	}
+
+	for _, p := range match.Info.Participants {
+		ps := newParticipantSummary(p, lookups, summary.Duration)
+		summary.Participants = append(summary.Participants, ps)
+
+		team, ok := teams[p.TeamID]
+		if !ok {
+			team = &TeamSummary{TeamID: p.TeamID, Win: p.Win}
+			teams[p.TeamID] = team
+		}
+
+		team.Participants = append(team.Participants, ps)
+		team.Kills += ps.Kills
+		team.Deaths += ps.Deaths
+		team.Assists += ps.Assists
+		team.Gold += ps.Gold
+		team.DamageToChampions += ps.DamageToChampions
+		team.CS += ps.CS
+		team.VisionScore += ps.VisionScore
+	}
+
+	for _, team := range teams {
+		for _, ps := range team.Participants {
+			ps.KillParticipation = ratio(float64(ps.Kills+ps.Assists), float64(team.Kills))
+			ps.GoldShare = ratio(float64(ps.Gold), float64(team.Gold))
+			ps.DamageShare = ratio(float64(ps.DamageToChampions), float64(team.DamageToChampions))
+		}
+
+		summary.Teams = append(summary.Teams, team)
+	}
+
+	sort.Slice(summary.Teams, func(i, j int) bool {
+		return summary.Teams[i].TeamID < summary.Teams[j].TeamID
+	})
+
+	return summary
+}
+
+// Participant returns the summary of the player, or nil if they are not in the match.
+func (m *MatchSummary) Participant(puuid string) *ParticipantSummary {
+	for _, p := range m.Participants {
+		if p.Puuid == puuid {
+			return p
+		}
+	}
+
+	return nil
+}
+
+// Team returns the summary of the team, or nil if it is not in the match.
+func (m *MatchSummary) Team(teamID int16) *TeamSummary {
+	for _, t := range m.Teams {
+		if t.TeamID == teamID {
+			return t
+		}
+	}
+
+	return nil
+}
+
+func newParticipantSummary(p apiclient.MatchInfoParticipant, lookups *Lookups, duration time.Duration) *ParticipantSummary {
+	ps := &ParticipantSummary{
+		ParticipantID:     p.ParticipantID,
+		Puuid:             p.SummonerPuuid,
+		GameName:          p.RiotIdGameName,
+		TagLine:           p.RiotIdTagline,
+		ChampionID:        p.ChampionID,
+		ChampionName:      p.ChampionName,
+		TeamID:            p.TeamID,
+		Position:          p.TeamPosition,
+		Win:               p.Win,
+		Level:             p.ChampLevel,
+		Kills:             p.Kills,
+		Deaths:            p.Deaths,
+		Assists:           p.Assists,
+		CS:                p.TotalMinionsKilled + p.NeutralMinionsKilled,
+		Gold:              p.GoldEarned,
+		DamageToChampions: p.TotalDamageDealtToChampions,
+		DamageTaken:       p.TotalDamageTaken,
+		VisionScore:       p.VisionScore,
+	}
+
+	deaths := ps.Deaths
+	if deaths == 0 {
+		deaths = 1
+	}
+	ps.KDA = float64(ps.Kills+ps.Assists) / float64(deaths)
+
+	ps.CSPerMinute = ratio(float64(ps.CS), duration.Minutes())
+	ps.VisionPerMinute = ratio(float64(ps.VisionScore), duration.Minutes())
+
+	for i, id := range []int32{p.Item0, p.Item1, p.Item2, p.Item3, p.Item4, p.Item5, p.Item6} {
+		ps.Items[i] = resolveItem(id, lookups.Items)
+	}
+
+	for i, id := range []summoner_spell.ID{p.Summoner1ID, p.Summoner2ID} {
+		ps.SummonerSpells[i] = SummonerSpell{ID: id}
+		if spell, err := lookups.SummonerSpells.SummonerSpell(int(id)); err == nil {
+			ps.SummonerSpells[i].Name = spell.Name
+		}
+	}
+
+	return ps
+}
+
+func resolveItem(id int32, items *staticdata.Items) Item {
+	item := Item{ID: id}
+	if id == 0 || items == nil {
+		return item
+	}
+
+	if data, err := items.Item(strconv.Itoa(int(id))); err == nil {
+		item.Name = data.Name
+	}
+
+	return item
+}
+
+func ratio(a, b float64) float64 {
+	if b == 0 {
+		return 0
+	}
+
+	return a / b
+}
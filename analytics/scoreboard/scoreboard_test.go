@@ -0,0 +1,93 @@
+package scoreboard
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Kinveil/Riot-API-Golang/apiclient"
+	"github.com/Kinveil/Riot-API-Golang/constants/summoner_spell"
+	"github.com/Kinveil/Riot-API-Golang/staticdata"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew(t *testing.T) {
+	match := &apiclient.Match{
+		Metadata: apiclient.MatchMetadata{MatchID: "NA1_1"},
+		Info: apiclient.MatchInfo{
+			GameDuration:     1800,
+			GameEndTimestamp: 1700001800000,
+			Teams: []apiclient.MatchInfoTeam{
+				{TeamID: 200, Win: false},
+				{TeamID: 100, Win: true},
+			},
+			Participants: []apiclient.MatchInfoParticipant{
+				{
+					ParticipantID:               1,
+					SummonerPuuid:               "a",
+					TeamID:                      100,
+					Win:                         true,
+					Kills:                       6,
+					Deaths:                      0,
+					Assists:                     4,
+					TotalMinionsKilled:          200,
+					NeutralMinionsKilled:        10,
+					GoldEarned:                  12000,
+					TotalDamageDealtToChampions: 30000,
+					VisionScore:                 15,
+					Item0:                       3031,
+					Summoner1ID:                 summoner_spell.SummonerFlash,
+				},
+				{
+					ParticipantID:               2,
+					SummonerPuuid:               "b",
+					TeamID:                      100,
+					Win:                         true,
+					Kills:                       4,
+					Deaths:                      2,
+					Assists:                     2,
+					GoldEarned:                  8000,
+					TotalDamageDealtToChampions: 10000,
+					VisionScore:                 45,
+				},
+				{ParticipantID: 3, SummonerPuuid: "c", TeamID: 200, Kills: 2, Deaths: 10},
+			},
+		},
+	}
+
+	lookups := &Lookups{
+		Items:          &staticdata.Items{Data: map[string]staticdata.Item{"3031": {Name: "Infinity Edge"}}},
+		SummonerSpells: staticdata.SummonerSpells{{ID: summoner_spell.SummonerFlash, Name: "Flash"}},
+	}
+
+	summary := New(match, lookups)
+	assert.Equal(t, 30*time.Minute, summary.Duration)
+	if assert.Len(t, summary.Teams, 2) {
+		assert.Equal(t, int16(100), summary.Teams[0].TeamID)
+		assert.Equal(t, int16(10), summary.Teams[0].Kills)
+		assert.Equal(t, int32(20000), summary.Teams[0].Gold)
+		assert.True(t, summary.Teams[0].Win)
+	}
+
+	a := summary.Participant("a")
+	if assert.NotNil(t, a) {
+		assert.Equal(t, float64(10), a.KDA)
+		assert.Equal(t, float64(1), a.KillParticipation)
+		assert.Equal(t, int32(210), a.CS)
+		assert.Equal(t, float64(7), a.CSPerMinute)
+		assert.Equal(t, 0.6, a.GoldShare)
+		assert.Equal(t, 0.75, a.DamageShare)
+		assert.Equal(t, 0.5, a.VisionPerMinute)
+		assert.Equal(t, Item{ID: 3031, Name: "Infinity Edge"}, a.Items[0])
+		assert.Equal(t, Item{}, a.Items[1])
+		assert.Equal(t, "Flash", a.SummonerSpells[0].Name)
+	}
+
+	b := summary.Participant("b")
+	if assert.NotNil(t, b) {
+		assert.Equal(t, float64(3), b.KDA)
+		assert.Equal(t, 0.6, b.KillParticipation)
+	}
+
+	assert.Nil(t, summary.Participant("z"))
+	assert.Equal(t, float64(1), New(match, nil).Participant("c").KillParticipation)
+}
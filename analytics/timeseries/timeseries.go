@@ -0,0 +1,260 @@
+package timeseries
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Kinveil/Riot-API-Golang/apiclient"
+)
+
+// Stat is a participant statistic tracked by the timeline frames.
+type Stat string
+
+const (
+	Gold              Stat = "GOLD"                // Total gold earned
+	CurrentGold       Stat = "CURRENT_GOLD"        // Unspent gold
+	XP                Stat = "XP"                  // Total experience
+	CS                Stat = "CS"                  // Lane and jungle minions killed
+	Level             Stat = "LEVEL"               // Champion level
+	DamageToChampions Stat = "DAMAGE_TO_CHAMPIONS" // Total damage dealt to champions
+	DamageTaken       Stat = "DAMAGE_TAKEN"        // Total damage taken
+)
+
+// Stats lists every Stat in a stable order.
+var Stats = []Stat{Gold, CurrentGold, XP, CS, Level, DamageToChampions, DamageTaken}
+
+func statValue(pf apiclient.MatchTimelineParticipantFrame, stat Stat) float64 {
+	switch stat {
+	case Gold:
+		return float64(pf.TotalGold)
+	case CurrentGold:
+		return float64(pf.CurrentGold)
+	case XP:
+		return float64(pf.XP)
+	case CS:
+		return float64(pf.MinionsKilled) + float64(pf.JungleMinionsKilled)
+	case Level:
+		return float64(pf.Level)
+	case DamageToChampions:
+		return float64(pf.DamageStats.TotalDamageDoneToChampions)
+	case DamageTaken:
+		return float64(pf.DamageStats.TotalDamageTaken)
+	default:
+		return 0
+	}
+}
+
+type Point struct {
+	Timestamp time.Duration
+	Value     float64
+}
+
+// Series is a list of points sorted by timestamp.
+type Series []Point
+
+// At returns the value at the timestamp, linearly interpolated between the surrounding points.
+// Timestamps before the first point or after the last point return the first or last value.
+func (s Series) At(timestamp time.Duration) float64 {
+	if len(s) == 0 {
+		return 0
+	}
+
+	i := sort.Search(len(s), func(i int) bool {
+		return s[i].Timestamp >= timestamp
+	})
+
+	if i == 0 {
+		return s[0].Value
+	}
+
+	if i == len(s) {
+		return s[len(s)-1].Value
+	}
+
+	prev, next := s[i-1], s[i]
+	if next.Timestamp == prev.Timestamp {
+		return next.Value
+	}
+
+	ratio := float64(timestamp-prev.Timestamp) / float64(next.Timestamp-prev.Timestamp)
+	return prev.Value + (next.Value-prev.Value)*ratio
+}
+
+// Sub returns s minus other, evaluated at each timestamp of s.
+func (s Series) Sub(other Series) Series {
+	res := make(Series, len(s))
+	for i, p := range s {
+		res[i] = Point{Timestamp: p.Timestamp, Value: p.Value - other.At(p.Timestamp)}
+	}
+
+	return res
+}
+
+// PerMinute returns the series divided by the game time in minutes at each point, ex: CS per minute.
+// The value at 0 minutes is 0.
+func (s Series) PerMinute() Series {
+	res := make(Series, len(s))
+	for i, p := range s {
+		res[i] = Point{Timestamp: p.Timestamp}
+		if p.Timestamp > 0 {
+			res[i].Value = p.Value / p.Timestamp.Minutes()
+		}
+	}
+
+	return res
+}
+
+type ParticipantSeries struct {
+	ParticipantID int16
+	Puuid         string
+	TeamID        int16
+	Position      string // Defaults to the match's TeamPosition, can be overwritten when it is wrong
+	Stats         map[Stat]Series
+}
+
+type TeamSeries struct {
+	TeamID int16
+	Stats  map[Stat]Series // Sum of the team's participants, with the last value of those missing from a frame
+}
+
+type Analysis struct {
+	Participants map[int16]*ParticipantSeries // By participant ID
+	Teams        map[int16]*TeamSeries        // By team ID
+}
+
+// New builds the time series of every participant and team of the match.
+func New(match *apiclient.Match, timeline *apiclient.MatchTimeline) (*Analysis, error) {
+	if match.Metadata.MatchID != timeline.Metadata.MatchID {
+		return nil, fmt.Errorf("timeline %s does not belong to match %s", timeline.Metadata.MatchID, match.Metadata.MatchID)
+	}
+
+	a := &Analysis{
+		Participants: make(map[int16]*ParticipantSeries),
+		Teams:        make(map[int16]*TeamSeries),
+	}
+
+	for _, participant := range match.Info.Participants {
+		a.Participants[participant.ParticipantID] = &ParticipantSeries{
+			ParticipantID: participant.ParticipantID,
+			Puuid:         participant.SummonerPuuid,
+			TeamID:        participant.TeamID,
+			Position:      participant.TeamPosition,
+			Stats:         make(map[Stat]Series),
+		}
+
+		if _, ok := a.Teams[participant.TeamID]; !ok {
+			a.Teams[participant.TeamID] = &TeamSeries{
+				TeamID: participant.TeamID,
+				Stats:  make(map[Stat]Series),
+			}
+		}
+	}
+
+	for _, frame := range timeline.Info.Frames {
+		timestamp := time.Duration(frame.Timestamp) * time.Millisecond
+
+		teamTotals := make(map[int16]map[Stat]float64)
+		for teamID := range a.Teams {
+			teamTotals[teamID] = make(map[Stat]float64)
+		}
+
+		present := make(map[int16]bool, len(frame.ParticipantFrames))
+		for _, pf := range frame.ParticipantFrames {
+			participant, ok := a.Participants[pf.ParticipantID]
+			if !ok {
+				continue
+			}
+
+			present[pf.ParticipantID] = true
+			for _, stat := range Stats {
+				value := statValue(pf, stat)
+				participant.Stats[stat] = append(participant.Stats[stat], Point{Timestamp: timestamp, Value: value})
+				teamTotals[participant.TeamID][stat] += value
+			}
+		}
+
+		// A participant missing from the frame keeps their previous value, so that the team total does not dip
+		for participantID, participant := range a.Participants {
+			if present[participantID] {
+				continue
+			}
+
+			for _, stat := range Stats {
+				if series := participant.Stats[stat]; len(series) > 0 {
+					teamTotals[participant.TeamID][stat] += series[len(series)-1].Value
+				}
+			}
+		}
+
+		for teamID, team := range a.Teams {
+			for _, stat := range Stats {
+				team.Stats[stat] = append(team.Stats[stat], Point{Timestamp: timestamp, Value: teamTotals[teamID][stat]})
+			}
+		}
+	}
+
+	return a, nil
+}
+
+// Participant returns the series of the player.
+func (a *Analysis) Participant(puuid string) (*ParticipantSeries, bool) {
+	for _, participant := range a.Participants {
+		if participant.Puuid == puuid {
+			return participant, true
+		}
+	}
+
+	return nil, false
+}
+
+// LaneOpponent returns the participant ID of the enemy playing the same position.
+func (a *Analysis) LaneOpponent(participantID int16) (int16, bool) {
+	participant, ok := a.Participants[participantID]
+	if !ok || participant.Position == "" {
+		return 0, false
+	}
+
+	for _, opponent := range a.Participants {
+		if opponent.TeamID != participant.TeamID && opponent.Position == participant.Position {
+			return opponent.ParticipantID, true
+		}
+	}
+
+	return 0, false
+}
+
+// LaneDiff returns the difference between the participant and their lane opponent over time, ex: gold diff.
+func (a *Analysis) LaneDiff(participantID int16, stat Stat) (Series, error) {
+	opponentID, ok := a.LaneOpponent(participantID)
+	if !ok {
+		return nil, fmt.Errorf("participant %d has no lane opponent", participantID)
+	}
+
+	return a.Participants[participantID].Stats[stat].Sub(a.Participants[opponentID].Stats[stat]), nil
+}
+
+// LaneDiffAt returns the difference between the participant and their lane opponent at the timestamp, ex: gold diff at 15 minutes.
+func (a *Analysis) LaneDiffAt(participantID int16, stat Stat, timestamp time.Duration) (float64, error) {
+	opponentID, ok := a.LaneOpponent(participantID)
+	if !ok {
+		return 0, fmt.Errorf("participant %d has no lane opponent", participantID)
+	}
+
+	return a.Participants[participantID].Stats[stat].At(timestamp) - a.Participants[opponentID].Stats[stat].At(timestamp), nil
+}
+
+// TeamDiff returns the difference between the two teams over time, ex: team gold diff.
+func (a *Analysis) TeamDiff(teamID, enemyTeamID int16, stat Stat) (Series, error) {
+	team, ok := a.Teams[teamID]
+	if !ok {
+		return nil, fmt.Errorf("team %d not found", teamID)
+	}
+
+	enemyTeam, ok := a.Teams[enemyTeamID]
+	if !ok {
+		return nil, fmt.Errorf("team %d not found", enemyTeamID)
+	}
+
+	return team.Stats[stat].Sub(enemyTeam.Stats[stat]), nil
+}
@@ -0,0 +1,101 @@
+package timeseries
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Kinveil/Riot-API-Golang/apiclient"
+	"github.com/stretchr/testify/assert"
+)
+
+func testMatch() (*apiclient.Match, *apiclient.MatchTimeline) {
+	match := &apiclient.Match{
+		Metadata: apiclient.MatchMetadata{MatchID: "NA1_1"},
+		Info: apiclient.MatchInfo{
+			Participants: []apiclient.MatchInfoParticipant{
+				{ParticipantID: 1, SummonerPuuid: "top-blue", TeamID: 100, TeamPosition: "TOP"},
+				{ParticipantID: 2, SummonerPuuid: "mid-blue", TeamID: 100, TeamPosition: "MIDDLE"},
+				{ParticipantID: 3, SummonerPuuid: "top-red", TeamID: 200, TeamPosition: "TOP"},
+				{ParticipantID: 4, SummonerPuuid: "mid-red", TeamID: 200, TeamPosition: "MIDDLE"},
+			},
+		},
+	}
+
+	frame := func(minute int32, gold [4]int32, cs [4]int32) apiclient.MatchTimelineFrame {
+		f := apiclient.MatchTimelineFrame{Timestamp: minute * 60000}
+		for i := 0; i < 4; i++ {
+			f.ParticipantFrames = append(f.ParticipantFrames, apiclient.MatchTimelineParticipantFrame{
+				ParticipantID: int16(i + 1),
+				TotalGold:     gold[i],
+				MinionsKilled: cs[i],
+			})
+		}
+		return f
+	}
+
+	timeline := &apiclient.MatchTimeline{
+		Metadata: apiclient.MatchTimelineMetadata{MatchID: "NA1_1"},
+		Info: apiclient.MatchTimelineInfo{
+			Frames: []apiclient.MatchTimelineFrame{
+				frame(0, [4]int32{500, 500, 500, 500}, [4]int32{0, 0, 0, 0}),
+				frame(10, [4]int32{4000, 3500, 3000, 3700}, [4]int32{80, 70, 60, 75}),
+				frame(20, [4]int32{8000, 7500, 6000, 7900}, [4]int32{160, 150, 120, 155}),
+			},
+		},
+	}
+
+	return match, timeline
+}
+
+func TestAnalysis(t *testing.T) {
+	analysis, err := New(testMatch())
+	assert.NoError(t, err)
+
+	opponent, ok := analysis.LaneOpponent(1)
+	assert.True(t, ok)
+	assert.Equal(t, int16(3), opponent)
+
+	diff, err := analysis.LaneDiffAt(1, Gold, 10*time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, float64(1000), diff)
+
+	// Interpolated halfway between the 10 and 20 minute frames
+	diff, err = analysis.LaneDiffAt(1, Gold, 15*time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, float64(1500), diff)
+
+	series, err := analysis.LaneDiff(2, CS)
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{0, -5, -5}, []float64{series[0].Value, series[1].Value, series[2].Value})
+
+	participant, ok := analysis.Participant("top-blue")
+	assert.True(t, ok)
+	assert.Equal(t, float64(8), participant.Stats[CS].PerMinute()[2].Value)
+
+	teamDiff, err := analysis.TeamDiff(100, 200, Gold)
+	assert.NoError(t, err)
+	assert.Equal(t, float64(800), teamDiff[1].Value)
+	assert.Equal(t, float64(15500), analysis.Teams[100].Stats[Gold].At(time.Hour))
+}
+
+func TestNewRejectsMismatchedTimeline(t *testing.T) {
+	match, timeline := testMatch()
+	timeline.Metadata.MatchID = "NA1_2"
+
+	_, err := New(match, timeline)
+	assert.Error(t, err)
+}
+
+func TestMissingParticipantFrame(t *testing.T) {
+	match, timeline := testMatch()
+
+	// Participant 1 is missing from the 10 minute frame
+	timeline.Info.Frames[1].ParticipantFrames = timeline.Info.Frames[1].ParticipantFrames[1:]
+
+	analysis, err := New(match, timeline)
+	assert.NoError(t, err)
+	assert.Len(t, analysis.Participants[1].Stats[Gold], 2)
+
+	gold := analysis.Teams[100].Stats[Gold]
+	assert.Equal(t, []float64{1000, 4000, 15500}, []float64{gold[0].Value, gold[1].Value, gold[2].Value})
+}
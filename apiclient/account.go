@@ -2,9 +2,13 @@ package apiclient
 
 import (
 	"fmt"
+	"net/url"
+	"strings"
 
 	"github.com/Kinveil/Riot-API-Golang/apiclient/ratelimiter"
 	"github.com/Kinveil/Riot-API-Golang/constants/continent"
+	"github.com/Kinveil/Riot-API-Golang/constants/game"
+	"github.com/Kinveil/Riot-API-Golang/constants/region"
 )
 
 type Account struct {
@@ -13,6 +17,25 @@ type Account struct {
 	TagLine  string `json:"tagLine"`
 }
 
+type AccountActiveShard struct {
+	Puuid       string    `json:"puuid"`
+	Game        game.Game `json:"game"`
+	ActiveShard string    `json:"activeShard"` // ex: na, eu, ap
+}
+
+type AccountActiveRegion struct {
+	Puuid  string    `json:"puuid"`
+	Game   game.Game `json:"game"`
+	Region string    `json:"region"` // ex: na1
+}
+
+// Platform returns the region the player is active on.
+// The boolean is false if the returned region is not a known League of Legends platform.
+func (a AccountActiveRegion) Platform() (region.Region, bool) {
+	r := region.Region(strings.ToUpper(a.Region))
+	return r, r.Host() != ""
+}
+
 func (c *uniqueClient) GetAccountByPuuid(continent continent.Continent, puuid string) (*Account, error) {
 	var account Account
 	err := c.dispatchAndUnmarshal(continent, "/riot/account/v1/accounts/by-puuid", fmt.Sprintf("/%s", puuid), nil, ratelimiter.GetAccountByPuuid, &account)
@@ -21,6 +44,32 @@ func (c *uniqueClient) GetAccountByPuuid(continent continent.Continent, puuid st
 
 func (c *uniqueClient) GetAccountByRiotID(continent continent.Continent, gameName, tagLine string) (*Account, error) {
 	var account Account
-	err := c.dispatchAndUnmarshal(continent, "/riot/account/v1/accounts/by-riot-id", fmt.Sprintf("/%s/%s", gameName, tagLine), nil, ratelimiter.GetAccountByRiotID, &account)
+	err := c.dispatchAndUnmarshal(continent, "/riot/account/v1/accounts/by-riot-id", fmt.Sprintf("/%s/%s", url.PathEscape(gameName), url.PathEscape(tagLine)), nil, ratelimiter.GetAccountByRiotID, &account)
 	return &account, err
 }
+
+// GetAccountByAccessToken returns the account of the player who owns the RSO access token.
+// The client must be created with WithAccessToken.
+func (c *uniqueClient) GetAccountByAccessToken(continent continent.Continent) (*Account, error) {
+	if err := c.requireAccessToken(); err != nil {
+		return nil, err
+	}
+
+	var account Account
+	err := c.dispatchAndUnmarshal(continent, "/riot/account/v1/accounts/me", "", nil, ratelimiter.GetAccountByAccessToken, &account)
+	return &account, err
+}
+
+func (c *uniqueClient) GetAccountActiveShard(continent continent.Continent, g game.Game, puuid string) (*AccountActiveShard, error) {
+	var res AccountActiveShard
+	err := c.dispatchAndUnmarshal(continent, "/riot/account/v1/active-shards/by-game", fmt.Sprintf("/%s/by-puuid/%s", g, puuid), nil, ratelimiter.GetAccountActiveShard, &res)
+	return &res, err
+}
+
+// GetAccountActiveRegion returns the platform a player is active on.
+// Riot only supports this lookup for League of Legends and Teamfight Tactics.
+func (c *uniqueClient) GetAccountActiveRegion(continent continent.Continent, g game.Game, puuid string) (*AccountActiveRegion, error) {
+	var res AccountActiveRegion
+	err := c.dispatchAndUnmarshal(continent, "/riot/account/v1/region/by-game", fmt.Sprintf("/%s/by-puuid/%s", g, puuid), nil, ratelimiter.GetAccountActiveRegion, &res)
+	return &res, err
+}
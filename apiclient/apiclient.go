@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"reflect"
@@ -12,7 +13,9 @@ import (
 	"time"
 
 	"github.com/Kinveil/Riot-API-Golang/apiclient/ratelimiter"
+	"github.com/Kinveil/Riot-API-Golang/constants/challenge_level"
 	"github.com/Kinveil/Riot-API-Golang/constants/continent"
+	"github.com/Kinveil/Riot-API-Golang/constants/game"
 	"github.com/Kinveil/Riot-API-Golang/constants/league/rank"
 	"github.com/Kinveil/Riot-API-Golang/constants/league/tier"
 	"github.com/Kinveil/Riot-API-Golang/constants/queue_ranked"
@@ -26,6 +29,23 @@ type Client interface {
 	WithPriority(priority int) Client
 	WithCache(duration time.Duration) Client
 
+	// WithAccessToken authenticates requests with an RSO access token (Authorization: Bearer)
+	// instead of the API key. It is required by the "me" endpoints.
+	WithAccessToken(accessToken string) Client
+
+	// WithTolerantDecoding decodes responses with DecodeTolerant, so that values that do not fit their field
+	// are clamped or skipped instead of failing the request. Warnings are appended to warnings if it is not nil.
+	// Responses served from the cache do not produce warnings again. They are cached apart from strict decodes.
+	// Clients derived from it append to the same warnings, which is safe for concurrent requests,
+	// but warnings must only be read once they are done.
+	WithTolerantDecoding(warnings *[]DecodeWarning) Client
+
+	// WithRaw stores the exact response Riot returned in raw, in addition to decoding it into the typed value.
+	// raw is also filled when Riot answers with an error status code, such as 404, but not when no response is received.
+	// Clients derived from it write to the same raw, which is safe for concurrent requests but then holds
+	// the last response received, so it should be read after a single request is done.
+	WithRaw(raw *RawResponse) Client
+
 	// Helper methods to set the API key, usage conservation, and max retries.
 	SetUsageConservation(conserveUsage ratelimiter.ConserveUsage)
 	SetAPIKey(apiKey string)
@@ -36,14 +56,26 @@ type Client interface {
 
 	GetAccountByPuuid(continent continent.Continent, puuid string) (*Account, error)
 	GetAccountByRiotID(continent continent.Continent, gameName, tagLine string) (*Account, error)
+	GetAccountByAccessToken(continent continent.Continent) (*Account, error)
+	GetAccountActiveShard(continent continent.Continent, game game.Game, puuid string) (*AccountActiveShard, error)
+	GetAccountActiveRegion(continent continent.Continent, game game.Game, puuid string) (*AccountActiveRegion, error)
 
 	/* Champion Mastery API */
 
+	// Deprecated: Riot has removed the by-summoner routes, use GetChampionMasteriesByPuuid instead.
 	GetChampionMasteriesBySummonerID(region region.Region, summonerID string) ([]ChampionMastery, error)
+	// Deprecated: Riot has removed the by-summoner routes, use GetChampionMasteryByPuuidAndChampionID instead.
 	GetChampionMasteryBySummonerIDAndChampionID(region region.Region, summonerID string, championID int) (*ChampionMastery, error)
+	// Deprecated: Riot has removed the by-summoner routes, use GetChampionMasteriesTopByPuuid instead.
 	GetChampionMasteriesTopBySummonerID(region region.Region, summonerID string) ([]ChampionMastery, error)
+	// Deprecated: Riot has removed the by-summoner routes, use GetChampionMasteryScoreTotalByPuuid instead.
 	GetChampionMasteryScoreTotalBySummonerID(region region.Region, summonerID string) (int, error)
 
+	GetChampionMasteriesByPuuid(region region.Region, puuid string) ([]ChampionMastery, error)
+	GetChampionMasteryByPuuidAndChampionID(region region.Region, puuid string, championID int) (*ChampionMastery, error)
+	GetChampionMasteriesTopByPuuid(region region.Region, puuid string, count int) ([]ChampionMastery, error)
+	GetChampionMasteryScoreTotalByPuuid(region region.Region, puuid string) (int, error)
+
 	/* Champion API */
 
 	GetChampionRotations(region region.Region) (*ChampionRotations, error)
@@ -73,13 +105,21 @@ type Client interface {
 
 	/* LOL Challenges API */
 
-	GetChallengesConfig(region region.Region) (*ChallengesConfig, error)
-	GetChallengesPercentiles(region region.Region) (*ChallengesPercentiles, error)
-	GetChallengesConfigByID(region region.Region, challengeID string) (*ChallengesConfig, error)
-	GetChallengesLeaderboardsByLevel(region region.Region, challengeID, level string) (*ChallengesLeaderboards, error)
-	GetChallengesPercentilesByID(region region.Region, challengeID string) (*ChallengesPercentiles, error)
+	GetChallengesConfig(region region.Region) ([]ChallengesConfig, error)
+	GetChallengesPercentiles(region region.Region) (ChallengesPercentiles, error)
+	GetChallengesConfigByID(region region.Region, challengeID int64) (*ChallengesConfig, error)
+	GetChallengesLeaderboardsByLevel(region region.Region, challengeID int64, level challenge_level.Level, limit int) ([]ChallengesLeaderboardEntry, error)
+	GetChallengesPercentilesByID(region region.Region, challengeID int64) (ChallengesLevelPercentiles, error)
 	GetChallengesPlayerDataByPuuid(region region.Region, puuid string) (*ChallengesPlayerData, error)
 
+	/* LOR Deck API */
+
+	GetLorDecks(continent continent.Continent) ([]LorDeck, error)
+
+	/* LOR Inventory API */
+
+	GetLorInventoryCards(continent continent.Continent) ([]LorCard, error)
+
 	/* LOL Status API */
 
 	GetStatusPlatformData(region region.Region) (*StatusPlatformData, error)
@@ -87,8 +127,13 @@ type Client interface {
 	/* Match API */
 
 	GetMatchlist(continent continent.Continent, puuid string, opts *GetMatchlistOptions) (*Matchlist, error)
+	GetMatchlistIterator(continent continent.Continent, puuid string, opts *GetMatchlistOptions) *MatchlistIterator
+	GetMatchlistByTournamentCode(continent continent.Continent, tournamentCode string) (*Matchlist, error)
 	GetMatch(continent continent.Continent, matchID string) (*Match, error)
+	GetMatchByID(matchID MatchID) (*Match, error)
 	GetMatchTimeline(continent continent.Continent, matchID string) (*MatchTimeline, error)
+	GetMatchTimelineByID(matchID MatchID) (*MatchTimeline, error)
+	GetMatchReplaysByPuuid(region region.Region, puuid string) (*MatchReplays, error)
 
 	/* Spectator API */
 
@@ -101,10 +146,16 @@ type Client interface {
 	GetSummonerByAccountID(region region.Region, accountID string) (*Summoner, error)
 	GetSummonerByPuuid(region region.Region, puuid string) (*Summoner, error)
 	GetSummonerBySummonerID(region region.Region, summonerID string) (*Summoner, error)
+	GetSummonerByAccessToken(region region.Region) (*Summoner, error)
+
+	/* Helpers */
+
+	ResolvePlayer(ctx context.Context, region region.Region, riotID string) (*Player, error)
 }
 
 type cacheEntry struct {
 	data   interface{}
+	raw    *RawResponse // Only kept when the response was requested with WithRaw
 	expiry time.Time
 }
 
@@ -113,6 +164,7 @@ type sharedClient struct {
 	cache                map[string]*cacheEntry
 	cacheMutex           sync.Mutex
 	cacheCleanupDuration time.Duration
+	outputMutex          sync.Mutex // Guards writes to the warnings and raw responses, which derived clients share
 }
 
 type uniqueClient struct {
@@ -120,6 +172,10 @@ type uniqueClient struct {
 	ctx           context.Context
 	priority      int
 	cacheDuration time.Duration
+	accessToken   string
+	tolerant      bool
+	warnings      *[]DecodeWarning
+	raw           *RawResponse
 }
 
 func New(apiKey string) Client {
@@ -149,6 +205,10 @@ func (c *uniqueClient) WithContext(ctx context.Context) Client {
 		ctx:           ctx,
 		priority:      c.priority,
 		cacheDuration: c.cacheDuration,
+		accessToken:   c.accessToken,
+		tolerant:      c.tolerant,
+		warnings:      c.warnings,
+		raw:           c.raw,
 	}
 }
 
@@ -158,6 +218,10 @@ func (c *uniqueClient) WithPriority(priority int) Client {
 		ctx:           c.ctx,
 		priority:      priority,
 		cacheDuration: c.cacheDuration,
+		accessToken:   c.accessToken,
+		tolerant:      c.tolerant,
+		warnings:      c.warnings,
+		raw:           c.raw,
 	}
 }
 
@@ -167,6 +231,49 @@ func (c *uniqueClient) WithCache(duration time.Duration) Client {
 		ctx:           c.ctx,
 		priority:      c.priority,
 		cacheDuration: duration,
+		accessToken:   c.accessToken,
+		tolerant:      c.tolerant,
+		warnings:      c.warnings,
+		raw:           c.raw,
+	}
+}
+
+func (c *uniqueClient) WithAccessToken(accessToken string) Client {
+	return &uniqueClient{
+		sharedClient:  c.sharedClient,
+		ctx:           c.ctx,
+		priority:      c.priority,
+		cacheDuration: c.cacheDuration,
+		accessToken:   accessToken,
+		tolerant:      c.tolerant,
+		warnings:      c.warnings,
+		raw:           c.raw,
+	}
+}
+
+func (c *uniqueClient) WithTolerantDecoding(warnings *[]DecodeWarning) Client {
+	return &uniqueClient{
+		sharedClient:  c.sharedClient,
+		ctx:           c.ctx,
+		priority:      c.priority,
+		cacheDuration: c.cacheDuration,
+		accessToken:   c.accessToken,
+		tolerant:      true,
+		warnings:      warnings,
+		raw:           c.raw,
+	}
+}
+
+func (c *uniqueClient) WithRaw(raw *RawResponse) Client {
+	return &uniqueClient{
+		sharedClient:  c.sharedClient,
+		ctx:           c.ctx,
+		priority:      c.priority,
+		cacheDuration: c.cacheDuration,
+		accessToken:   c.accessToken,
+		tolerant:      c.tolerant,
+		warnings:      c.warnings,
+		raw:           raw,
 	}
 }
 
@@ -186,6 +293,15 @@ func (c *uniqueClient) SetCacheCleanupDuration(duration time.Duration) {
 	c.cacheCleanupDuration = duration
 }
 
+// requireAccessToken returns an error if the client was not created with WithAccessToken.
+func (c *uniqueClient) requireAccessToken() error {
+	if c.accessToken == "" {
+		return fmt.Errorf("an access token is required, use WithAccessToken")
+	}
+
+	return nil
+}
+
 type HostProvider interface {
 	Host() string
 	String() string
@@ -204,8 +320,19 @@ func (c *uniqueClient) dispatchAndUnmarshal(regionOrContinent HostProvider, meth
 
 	URL := regionOrContinent.Host() + method + separator + relativePath + suffix
 
-	// Check if in cache
-	if cachedData, ok := c.getFromCache(URL); ok {
+	// "me" endpoints share a URL between users, so the access token must be part of the cache key
+	cacheKey := URL
+	if c.accessToken != "" {
+		cacheKey = c.accessToken + " " + URL
+	}
+
+	// Tolerant decodes can hold clamped values and miss fields, so they must not be served to strict clients
+	if c.tolerant {
+		cacheKey = "tolerant " + cacheKey
+	}
+
+	// Check if in cache, entries without a raw response cannot serve WithRaw requests
+	if cachedData, cachedRaw, ok := c.getFromCache(cacheKey); ok && (c.raw == nil || cachedRaw != nil) {
 		copy, err := deepcopy.Anything(cachedData)
 		if err != nil {
 			return err
@@ -228,19 +355,26 @@ func (c *uniqueClient) dispatchAndUnmarshal(regionOrContinent HostProvider, meth
 
 		destElem.Set(copyValue)
 
+		if c.raw != nil {
+			c.outputMutex.Lock()
+			*c.raw = *cachedRaw.clone()
+			c.outputMutex.Unlock()
+		}
+
 		return nil
 	}
 
 	responseChan := make(chan *http.Response, 1)
 	errorChan := make(chan error, 1)
 	newRequest := ratelimiter.APIRequest{
-		Context:  c.ctx,
-		Priority: c.priority,
-		Region:   strings.ToUpper(regionOrContinent.String()),
-		MethodID: methodID,
-		URL:      URL,
-		Response: responseChan,
-		Error:    errorChan,
+		Context:     c.ctx,
+		Priority:    c.priority,
+		Region:      strings.ToUpper(regionOrContinent.String()),
+		MethodID:    methodID,
+		URL:         URL,
+		AccessToken: c.accessToken,
+		Response:    responseChan,
+		Error:       errorChan,
 	}
 
 	// Insert the request into the rate limiter
@@ -263,54 +397,82 @@ func (c *uniqueClient) dispatchAndUnmarshal(regionOrContinent HostProvider, meth
 
 		defer response.Body.Close()
 
+		body, err := io.ReadAll(response.Body)
+		if err != nil {
+			return fmt.Errorf("failed to read response: %w (%s)", err, URL)
+		}
+
+		var raw *RawResponse
+		if c.raw != nil {
+			raw = &RawResponse{
+				StatusCode: response.StatusCode,
+				Header:     response.Header,
+				Body:       body,
+			}
+			c.outputMutex.Lock()
+			*c.raw = *raw.clone()
+			c.outputMutex.Unlock()
+		}
+
 		if response.StatusCode != http.StatusOK {
 			if err, ok := StatusToError[response.StatusCode]; ok {
-				return fmt.Errorf("status code %d: %s (%s)", response.StatusCode, err, URL)
+				return fmt.Errorf("status code %d: %w (%s)", response.StatusCode, err, URL)
 			}
 
 			return fmt.Errorf("status code %d: unknown error (%s)", response.StatusCode, URL)
 		}
 
-		decoder := json.NewDecoder(response.Body)
-		if err := decoder.Decode(dest); err != nil {
+		if c.tolerant {
+			warnings, err := DecodeTolerant(body, dest)
+			if err != nil {
+				return fmt.Errorf("failed to decode response: %w (%s)", err, URL)
+			}
+
+			if c.warnings != nil {
+				c.outputMutex.Lock()
+				*c.warnings = append(*c.warnings, warnings...)
+				c.outputMutex.Unlock()
+			}
+		} else if err := json.Unmarshal(body, dest); err != nil {
 			return fmt.Errorf("failed to decode response: %w (%s)", err, URL)
 		}
 
 		// Cache the destination
 		if c.cacheDuration > 0 {
-			c.addToCache(URL, dest)
+			c.addToCache(cacheKey, dest, raw)
 		}
 
 		return nil
 	}
 }
 
-func (c *uniqueClient) getFromCache(URL string) (interface{}, bool) {
+func (c *uniqueClient) getFromCache(key string) (interface{}, *RawResponse, bool) {
 	c.cacheMutex.Lock()
 
-	if entry, ok := c.cache[URL]; ok {
+	if entry, ok := c.cache[key]; ok {
 		if time.Now().Before(entry.expiry) {
-			value := entry.data
+			value, raw := entry.data, entry.raw
 			c.cacheMutex.Unlock()
-			return value, true
+			return value, raw, true
 		}
 
-		delete(c.cache, URL)
+		delete(c.cache, key)
 	}
 
 	c.cacheMutex.Unlock()
-	return nil, false
+	return nil, nil, false
 }
 
-func (c *uniqueClient) addToCache(URL string, data interface{}) {
+func (c *uniqueClient) addToCache(key string, data interface{}, raw *RawResponse) {
 	copy, err := deepcopy.Anything(data)
 	if err != nil {
 		return
 	}
 
 	c.cacheMutex.Lock()
-	c.cache[URL] = &cacheEntry{
+	c.cache[key] = &cacheEntry{
 		data:   copy,
+		raw:    raw.clone(),
 		expiry: time.Now().Add(c.cacheDuration),
 	}
 	c.cacheMutex.Unlock()
@@ -330,9 +492,9 @@ func (c *uniqueClient) removeExpiredEntries() {
 	c.cacheMutex.Lock()
 
 	now := time.Now()
-	for URL, entry := range c.cache {
+	for key, entry := range c.cache {
 		if now.After(entry.expiry) {
-			delete(c.cache, URL)
+			delete(c.cache, key)
 		}
 	}
 
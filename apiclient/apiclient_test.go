@@ -0,0 +1,101 @@
+package apiclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/Kinveil/Riot-API-Golang/apiclient/ratelimiter"
+	"github.com/Kinveil/Riot-API-Golang/constants/region"
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestClient returns a client whose requests are answered by handler instead of Riot's servers.
+func newTestClient(t *testing.T, handler func(req *ratelimiter.APIRequest) (int, interface{})) *uniqueClient {
+	t.Helper()
+
+	requests := make(chan *ratelimiter.APIRequest)
+	t.Cleanup(func() { close(requests) })
+
+	go func() {
+		for req := range requests {
+			status, body := handler(req)
+			data, _ := json.Marshal(body)
+			req.Response <- &http.Response{
+				StatusCode: status,
+				Header:     http.Header{},
+				Body:       io.NopCloser(bytes.NewReader(data)),
+			}
+		}
+	}()
+
+	return &uniqueClient{
+		sharedClient: &sharedClient{
+			ratelimiter: &ratelimiter.RateLimiter{Requests: requests},
+			cache:       make(map[string]*cacheEntry),
+		},
+		ctx: context.Background(),
+	}
+}
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// newRateLimitedTestClient returns a client whose requests go through the rate limiter, and are answered by handler instead of Riot's servers.
+func newRateLimitedTestClient(t *testing.T, handler func(req *http.Request) (int, string)) *uniqueClient {
+	t.Helper()
+
+	requests := make(chan *ratelimiter.APIRequest)
+	t.Cleanup(func() { close(requests) })
+
+	rl := ratelimiter.NewRateLimiter(requests, "RGAPI-test")
+	rl.SetMaxRetries(0)
+	rl.SetHTTPClient(&http.Client{Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		status, body := handler(req)
+		return &http.Response{
+			StatusCode: status,
+			Header:     http.Header{},
+			Body:       io.NopCloser(bytes.NewReader([]byte(body))),
+			Request:    req,
+		}, nil
+	})})
+	go rl.Start()
+
+	return &uniqueClient{
+		sharedClient: &sharedClient{
+			ratelimiter: rl,
+			cache:       make(map[string]*cacheEntry),
+		},
+		ctx: context.Background(),
+	}
+}
+
+func TestStatusErrors(t *testing.T) {
+	client := newRateLimitedTestClient(t, func(req *http.Request) (int, string) {
+		switch req.URL.Path {
+		case "/lol/spectator/v5/active-games/by-summoner/not-in-game":
+			return http.StatusNotFound, `{"status":{"status_code":404}}`
+		case "/lol/spectator/v5/active-games/by-summoner/bad":
+			return http.StatusBadRequest, `{"status":{"status_code":400}}`
+		}
+
+		return http.StatusOK, `{"gameId":1,"platformId":"NA1"}`
+	})
+
+	_, err := client.GetSpectatorActiveGameByPuuid(region.NA1, "not-in-game")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	_, err = client.GetSpectatorActiveGameByPuuid(region.NA1, "bad")
+	assert.ErrorIs(t, err, ErrBadRequest)
+
+	game, err := client.GetSpectatorActiveGameByPuuid(region.NA1, "in-game")
+	if assert.NoError(t, err) {
+		assert.Equal(t, int64(1), game.GameID)
+	}
+}
@@ -2,22 +2,72 @@ package apiclient
 
 import (
 	"fmt"
+	"net/url"
 
 	"github.com/Kinveil/Riot-API-Golang/apiclient/ratelimiter"
 	"github.com/Kinveil/Riot-API-Golang/constants/region"
 )
 
 type ChampionMastery struct {
-	Puuid                        string `json:"puuid"`
-	ChampionID                   int32  `json:"championId"`
-	ChampionLevel                int64  `json:"championLevel"`
-	ChampionPoints               int64  `json:"championPoints"`
-	LastPlayTime                 int64  `json:"lastPlayTime"`
-	ChampionPointsSinceLastLevel int64  `json:"championPointsSinceLastLevel"`
-	ChampionPointsUntilNextLevel int64  `json:"championPointsUntilNextLevel"`
-	ChestGranted                 bool   `json:"chestGranted"`
-	TokensEarned                 int64  `json:"tokensEarned"`
-	SummonerID                   string `json:"summonerId"`
+	Puuid                        string                              `json:"puuid"`
+	ChampionID                   int32                               `json:"championId"`
+	ChampionLevel                int64                               `json:"championLevel"`
+	ChampionPoints               int64                               `json:"championPoints"`
+	LastPlayTime                 int64                               `json:"lastPlayTime"`
+	ChampionPointsSinceLastLevel int64                               `json:"championPointsSinceLastLevel"`
+	ChampionPointsUntilNextLevel int64                               `json:"championPointsUntilNextLevel"`
+	MarkRequiredForNextLevel     int32                               `json:"markRequiredForNextLevel"`
+	ChampionSeasonMilestone      int32                               `json:"championSeasonMilestone"`
+	MilestoneGrades              []string                            `json:"milestoneGrades"` // ex: S+, A-
+	NextSeasonMilestone          *ChampionMasteryNextSeasonMilestone `json:"nextSeasonMilestone"`
+	ChestGranted                 bool                                `json:"chestGranted"`
+	TokensEarned                 int64                               `json:"tokensEarned"`
+	SummonerID                   string                              `json:"summonerId"`
+}
+
+type ChampionMasteryNextSeasonMilestone struct {
+	RequireGradeCounts map[string]int32             `json:"requireGradeCounts"` // ex: {"A-": 1}
+	RewardMarks        int32                        `json:"rewardMarks"`
+	Bonus              bool                         `json:"bonus"`
+	RewardConfig       *ChampionMasteryRewardConfig `json:"rewardConfig"`
+}
+
+type ChampionMasteryRewardConfig struct {
+	RewardValue   string `json:"rewardValue"`
+	RewardType    string `json:"rewardType"`
+	MaximumReward int32  `json:"maximumReward"`
+}
+
+func (c *uniqueClient) GetChampionMasteriesByPuuid(r region.Region, puuid string) ([]ChampionMastery, error) {
+	var res []ChampionMastery
+	err := c.dispatchAndUnmarshal(r, "/lol/champion-mastery/v4/champion-masteries/by-puuid", fmt.Sprintf("/%s", puuid), nil, ratelimiter.GetChampionMasteriesByPuuid, &res)
+	return res, err
+}
+
+func (c *uniqueClient) GetChampionMasteryByPuuidAndChampionID(r region.Region, puuid string, championID int) (*ChampionMastery, error) {
+	var res ChampionMastery
+	err := c.dispatchAndUnmarshal(r, "/lol/champion-mastery/v4/champion-masteries/by-puuid", fmt.Sprintf("/%s/by-champion/%d", puuid, championID), nil, ratelimiter.GetChampionMasteryByPuuidAndChampionID, &res)
+	return &res, err
+}
+
+// GetChampionMasteriesTopByPuuid returns the player's highest mastery champions.
+// If count is 0 or less, Riot's default of 3 champions is used.
+func (c *uniqueClient) GetChampionMasteriesTopByPuuid(r region.Region, puuid string, count int) ([]ChampionMastery, error) {
+	var params url.Values = make(map[string][]string)
+
+	if count > 0 {
+		params.Add("count", fmt.Sprintf("%d", count))
+	}
+
+	var res []ChampionMastery
+	err := c.dispatchAndUnmarshal(r, "/lol/champion-mastery/v4/champion-masteries/by-puuid", fmt.Sprintf("/%s/top", puuid), params, ratelimiter.GetChampionMasteriesTopByPuuid, &res)
+	return res, err
+}
+
+func (c *uniqueClient) GetChampionMasteryScoreTotalByPuuid(r region.Region, puuid string) (int, error) {
+	var res int
+	err := c.dispatchAndUnmarshal(r, "/lol/champion-mastery/v4/scores/by-puuid", fmt.Sprintf("/%s", puuid), nil, ratelimiter.GetChampionMasteryScoreTotalByPuuid, &res)
+	return res, err
 }
 
 func (c *uniqueClient) GetChampionMasteriesBySummonerID(r region.Region, summonerID string) ([]ChampionMastery, error) {
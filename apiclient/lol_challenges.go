@@ -2,81 +2,147 @@ package apiclient
 
 import (
 	"fmt"
+	"net/url"
 
 	"github.com/Kinveil/Riot-API-Golang/apiclient/ratelimiter"
-	"github.com/Kinveil/Riot-API-Golang/constants/queue"
+	"github.com/Kinveil/Riot-API-Golang/constants/challenge_level"
+	"github.com/Kinveil/Riot-API-Golang/constants/language"
 	"github.com/Kinveil/Riot-API-Golang/constants/region"
 )
 
 type ChallengesConfig struct {
-	ChallengeID string `json:"challengeId"`
-	Levels      []struct {
-		LevelID string `json:"levelId"`
-		Order   int    `json:"order"`
-	} `json:"levels"`
-	QueueID queue.ID `json:"queueId"`
+	ID             int64                                               `json:"id"`
+	LocalizedNames map[language.Language]ChallengesConfigLocalizedName `json:"localizedNames"`
+	State          ChallengesConfigState                               `json:"state"`
+	Tracking       ChallengesConfigTracking                            `json:"tracking"`
+	StartTimestamp int64                                               `json:"startTimestamp"`
+	EndTimestamp   int64                                               `json:"endTimestamp"`
+	Leaderboard    bool                                                `json:"leaderboard"`
+	Thresholds     map[challenge_level.Level]float64                   `json:"thresholds"` // The value required to reach each level
 }
 
-type ChallengesLeaderboards struct {
-	Entries []struct {
-		Entries []struct {
-			Percentile float64 `json:"percentile"`
-			Rank       int     `json:"rank"`
-			Total      int     `json:"total"`
-		} `json:"entries"`
-		LevelID string `json:"levelId"`
-	} `json:"entries"`
-	QueueID queue.ID `json:"queueId"`
+type ChallengesConfigLocalizedName struct {
+	Description      string `json:"description"`
+	Name             string `json:"name"`
+	ShortDescription string `json:"shortDescription"`
 }
 
-type ChallengesPercentiles struct {
-	Percentiles []struct {
-		Percentile float64 `json:"percentile"`
-		Total      int     `json:"total"`
-	} `json:"percentiles"`
-	QueueID queue.ID `json:"queueId"`
+type ChallengesConfigState string
+
+const (
+	ChallengesConfigState_Disabled ChallengesConfigState = "DISABLED" // Not visible and not calculated
+	ChallengesConfigState_Hidden   ChallengesConfigState = "HIDDEN"   // Not visible, but calculated
+	ChallengesConfigState_Enabled  ChallengesConfigState = "ENABLED"  // Visible and calculated
+	ChallengesConfigState_Archived ChallengesConfigState = "ARCHIVED" // Visible, but not calculated
+)
+
+type ChallengesConfigTracking string
+
+const (
+	ChallengesConfigTracking_Lifetime ChallengesConfigTracking = "LIFETIME" // Stats are incremented without reset
+	ChallengesConfigTracking_Season   ChallengesConfigTracking = "SEASON"   // Stats are accumulated by season and reset at the beginning of a new season
+)
+
+// Name returns the localized name of the challenge, falling back to English if the language is missing.
+func (c ChallengesConfig) Name(lang language.Language) string {
+	if name, ok := c.LocalizedNames[lang]; ok {
+		return name.Name
+	}
+
+	return c.LocalizedNames[language.EnglishUnitedStates].Name
+}
+
+// ChallengesPercentiles maps challenge IDs to the percentile of players at each level.
+type ChallengesPercentiles map[int64]ChallengesLevelPercentiles
+
+// ChallengesLevelPercentiles maps levels to the percentile of players that reached them.
+type ChallengesLevelPercentiles map[challenge_level.Level]float64
+
+type ChallengesLeaderboardEntry struct {
+	Puuid    string  `json:"puuid"`
+	Value    float64 `json:"value"`
+	Position int32   `json:"position"`
 }
 
 type ChallengesPlayerData struct {
-	Entries []struct {
-		Entries []struct {
-			Percentile float64 `json:"percentile"`
-			Rank       int     `json:"rank"`
-			Total      int     `json:"total"`
-		} `json:"entries"`
-		LevelID string `json:"levelId"`
-	} `json:"entries"`
-	QueueID queue.ID `json:"queueId"`
+	Challenges     []ChallengesPlayerChallenge `json:"challenges"`
+	Preferences    ChallengesPlayerPreferences `json:"preferences"`
+	TotalPoints    ChallengesPoints            `json:"totalPoints"`
+	CategoryPoints map[string]ChallengesPoints `json:"categoryPoints"` // ex: COLLECTION, EXPERTISE, IMAGINATION, TEAMWORK, VETERANCY
 }
 
-func (c *uniqueClient) GetChallengesConfig(r region.Region) (*ChallengesConfig, error) {
-	var res ChallengesConfig
-	err := c.dispatchAndUnmarshal(r, "/lol/challenges/v1/config", "", nil, ratelimiter.GetChallengesConfig, &res)
-	return &res, err
+type ChallengesPlayerChallenge struct {
+	ChallengeID    int64                 `json:"challengeId"`
+	Percentile     float64               `json:"percentile"`
+	Level          challenge_level.Level `json:"level"`
+	Value          float64               `json:"value"`
+	AchievedTime   int64                 `json:"achievedTime"`
+	Position       int64                 `json:"position"`       // Only set for challenges with a leaderboard
+	PlayersInLevel int64                 `json:"playersInLevel"` // Only set for challenges with a leaderboard
+}
+
+type ChallengesPlayerPreferences struct {
+	BannerAccent             string  `json:"bannerAccent"`
+	Title                    string  `json:"title"`
+	ChallengeIDs             []int64 `json:"challengeIds"`
+	CrestBorder              string  `json:"crestBorder"`
+	PrestigeCrestBorderLevel int32   `json:"prestigeCrestBorderLevel"`
+}
+
+type ChallengesPoints struct {
+	Level      challenge_level.Level `json:"level"`
+	Current    int64                 `json:"current"`
+	Max        int64                 `json:"max"`
+	Percentile float64               `json:"percentile"`
+}
+
+// Challenge returns the player's progress in the challenge with the given ID.
+func (p ChallengesPlayerData) Challenge(challengeID int64) (ChallengesPlayerChallenge, bool) {
+	for _, challenge := range p.Challenges {
+		if challenge.ChallengeID == challengeID {
+			return challenge, true
+		}
+	}
+
+	return ChallengesPlayerChallenge{}, false
+}
+
+func (c *uniqueClient) GetChallengesConfig(r region.Region) ([]ChallengesConfig, error) {
+	var res []ChallengesConfig
+	err := c.dispatchAndUnmarshal(r, "/lol/challenges/v1/challenges/config", "", nil, ratelimiter.GetChallengesConfig, &res)
+	return res, err
 }
 
-func (c *uniqueClient) GetChallengesPercentiles(r region.Region) (*ChallengesPercentiles, error) {
+func (c *uniqueClient) GetChallengesPercentiles(r region.Region) (ChallengesPercentiles, error) {
 	var res ChallengesPercentiles
-	err := c.dispatchAndUnmarshal(r, "/lol/challenges/v1/percentiles", "", nil, ratelimiter.GetChallengesPercentiles, &res)
-	return &res, err
+	err := c.dispatchAndUnmarshal(r, "/lol/challenges/v1/challenges/percentiles", "", nil, ratelimiter.GetChallengesPercentiles, &res)
+	return res, err
 }
 
-func (c *uniqueClient) GetChallengesConfigByID(r region.Region, challengeID string) (*ChallengesConfig, error) {
+func (c *uniqueClient) GetChallengesConfigByID(r region.Region, challengeID int64) (*ChallengesConfig, error) {
 	var res ChallengesConfig
-	err := c.dispatchAndUnmarshal(r, "/lol/challenges/v1/config", fmt.Sprintf("/%s", challengeID), nil, ratelimiter.GetChallengesConfigByID, &res)
+	err := c.dispatchAndUnmarshal(r, "/lol/challenges/v1/challenges", fmt.Sprintf("/%d/config", challengeID), nil, ratelimiter.GetChallengesConfigByID, &res)
 	return &res, err
 }
 
-func (c *uniqueClient) GetChallengesLeaderboardsByLevel(r region.Region, challengeID, level string) (*ChallengesLeaderboards, error) {
-	var res ChallengesLeaderboards
-	err := c.dispatchAndUnmarshal(r, "/lol/challenges/v1/leaderboards", fmt.Sprintf("/%s/%s", challengeID, level), nil, ratelimiter.GetChallengesLeaderboardsByLevel, &res)
-	return &res, err
+// GetChallengesLeaderboardsByLevel returns the top players of a challenge.
+// Only MASTER, GRANDMASTER and CHALLENGER have leaderboards. If limit is 0 or less, Riot's default is used.
+func (c *uniqueClient) GetChallengesLeaderboardsByLevel(r region.Region, challengeID int64, level challenge_level.Level, limit int) ([]ChallengesLeaderboardEntry, error) {
+	var params url.Values = make(map[string][]string)
+
+	if limit > 0 {
+		params.Add("limit", fmt.Sprintf("%d", limit))
+	}
+
+	var res []ChallengesLeaderboardEntry
+	err := c.dispatchAndUnmarshal(r, "/lol/challenges/v1/challenges", fmt.Sprintf("/%d/leaderboards/by-level/%s", challengeID, level), params, ratelimiter.GetChallengesLeaderboardsByLevel, &res)
+	return res, err
 }
 
-func (c *uniqueClient) GetChallengesPercentilesByID(r region.Region, challengeID string) (*ChallengesPercentiles, error) {
-	var res ChallengesPercentiles
-	err := c.dispatchAndUnmarshal(r, "/lol/challenges/v1/percentiles", fmt.Sprintf("/%s", challengeID), nil, ratelimiter.GetChallengesPercentilesByID, &res)
-	return &res, err
+func (c *uniqueClient) GetChallengesPercentilesByID(r region.Region, challengeID int64) (ChallengesLevelPercentiles, error) {
+	var res ChallengesLevelPercentiles
+	err := c.dispatchAndUnmarshal(r, "/lol/challenges/v1/challenges", fmt.Sprintf("/%d/percentiles", challengeID), nil, ratelimiter.GetChallengesPercentilesByID, &res)
+	return res, err
 }
 
 func (c *uniqueClient) GetChallengesPlayerDataByPuuid(r region.Region, puuid string) (*ChallengesPlayerData, error) {
@@ -0,0 +1,96 @@
+package apiclient
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/Kinveil/Riot-API-Golang/constants/challenge_level"
+	"github.com/Kinveil/Riot-API-Golang/constants/language"
+	"github.com/stretchr/testify/assert"
+)
+
+func loadFixture(t *testing.T, name string, dest interface{}) {
+	t.Helper()
+
+	data, err := os.ReadFile("testdata/" + name)
+	if err != nil {
+		t.Fatalf("Failed to read fixture %s: %v", name, err)
+	}
+
+	if err := json.Unmarshal(data, dest); err != nil {
+		t.Fatalf("Failed to decode fixture %s: %v", name, err)
+	}
+}
+
+func TestChallengesConfigFixture(t *testing.T) {
+	var configs []ChallengesConfig
+	loadFixture(t, "challenges_config.json", &configs)
+
+	assert.Len(t, configs, 2)
+
+	crystal := configs[0]
+	assert.Equal(t, int64(0), crystal.ID)
+	assert.Equal(t, ChallengesConfigState_Enabled, crystal.State)
+	assert.True(t, crystal.Leaderboard)
+	assert.Equal(t, "CRYSTAL", crystal.Name(language.EnglishUnitedStates))
+	assert.Equal(t, "크리스탈", crystal.Name(language.KoreanSouthKorea))
+	assert.Equal(t, "CRYSTAL", crystal.Name(language.FrenchFrance))
+	assert.Equal(t, float64(10000), crystal.Thresholds[challenge_level.Master])
+
+	aram := configs[1]
+	assert.Equal(t, int64(101000), aram.ID)
+	assert.Equal(t, ChallengesConfigTracking_Lifetime, aram.Tracking)
+	assert.Equal(t, int64(1651262400000), aram.StartTimestamp)
+	assert.Equal(t, 2000.5, aram.Thresholds[challenge_level.Diamond])
+}
+
+func TestChallengesPercentilesFixture(t *testing.T) {
+	var percentiles ChallengesPercentiles
+	loadFixture(t, "challenges_percentiles.json", &percentiles)
+
+	assert.Len(t, percentiles, 2)
+	assert.Equal(t, 0.0001, percentiles[0][challenge_level.Challenger])
+	assert.Equal(t, 0.31, percentiles[101000][challenge_level.Gold])
+}
+
+func TestChallengesLeaderboardFixture(t *testing.T) {
+	var leaderboard []ChallengesLeaderboardEntry
+	loadFixture(t, "challenges_leaderboard.json", &leaderboard)
+
+	assert.Equal(t, []ChallengesLeaderboardEntry{
+		{Puuid: "puuid-1", Value: 37520, Position: 1},
+		{Puuid: "puuid-2", Value: 37012.5, Position: 2},
+	}, leaderboard)
+}
+
+func TestChallengesPlayerDataFixture(t *testing.T) {
+	var playerData ChallengesPlayerData
+	loadFixture(t, "challenges_player_data.json", &playerData)
+
+	assert.Equal(t, ChallengesPoints{Level: challenge_level.Diamond, Current: 9120, Max: 46410, Percentile: 0.012}, playerData.TotalPoints)
+	assert.Equal(t, challenge_level.Platinum, playerData.CategoryPoints["TEAMWORK"].Level)
+	assert.Equal(t, []int64{101101, 202303}, playerData.Preferences.ChallengeIDs)
+	assert.Equal(t, int32(1), playerData.Preferences.PrestigeCrestBorderLevel)
+
+	challenge, ok := playerData.Challenge(101101)
+	assert.True(t, ok)
+	assert.Equal(t, challenge_level.Master, challenge.Level)
+	assert.Equal(t, int64(154), challenge.Position)
+	assert.Equal(t, int64(3200), challenge.PlayersInLevel)
+
+	challenge, ok = playerData.Challenge(202303)
+	assert.True(t, ok)
+	assert.Equal(t, 3.5, challenge.Value)
+	assert.Zero(t, challenge.Position)
+
+	_, ok = playerData.Challenge(1)
+	assert.False(t, ok)
+}
+
+func TestChallengeLevelOrder(t *testing.T) {
+	assert.Less(t, challenge_level.Gold.Order(), challenge_level.Master.Order())
+	assert.Equal(t, -1, challenge_level.Highest.Order())
+	assert.True(t, challenge_level.Grandmaster.HasLeaderboard())
+	assert.False(t, challenge_level.Diamond.HasLeaderboard())
+}
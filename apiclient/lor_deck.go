@@ -0,0 +1,24 @@
+package apiclient
+
+import (
+	"github.com/Kinveil/Riot-API-Golang/apiclient/ratelimiter"
+	"github.com/Kinveil/Riot-API-Golang/constants/continent"
+)
+
+type LorDeck struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Code string `json:"code"`
+}
+
+// GetLorDecks returns the Legends of Runeterra decks of the player who owns the RSO access token.
+// The client must be created with WithAccessToken.
+func (c *uniqueClient) GetLorDecks(continent continent.Continent) ([]LorDeck, error) {
+	if err := c.requireAccessToken(); err != nil {
+		return nil, err
+	}
+
+	var res []LorDeck
+	err := c.dispatchAndUnmarshal(continent, "/lor/deck/v1/decks/me", "", nil, ratelimiter.GetLorDecks, &res)
+	return res, err
+}
@@ -0,0 +1,23 @@
+package apiclient
+
+import (
+	"github.com/Kinveil/Riot-API-Golang/apiclient/ratelimiter"
+	"github.com/Kinveil/Riot-API-Golang/constants/continent"
+)
+
+type LorCard struct {
+	Code  string `json:"code"`
+	Count string `json:"count"`
+}
+
+// GetLorInventoryCards returns the Legends of Runeterra cards owned by the player who owns the RSO access token.
+// The client must be created with WithAccessToken.
+func (c *uniqueClient) GetLorInventoryCards(continent continent.Continent) ([]LorCard, error) {
+	if err := c.requireAccessToken(); err != nil {
+		return nil, err
+	}
+
+	var res []LorCard
+	err := c.dispatchAndUnmarshal(continent, "/lor/inventory/v1/cards/me", "", nil, ratelimiter.GetLorInventoryCards, &res)
+	return res, err
+}
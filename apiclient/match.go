@@ -1,9 +1,12 @@
 package apiclient
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"sort"
+	"strconv"
 	"time"
 
 	"github.com/Kinveil/Riot-API-Golang/apiclient/ratelimiter"
@@ -14,8 +17,8 @@ import (
 	"github.com/Kinveil/Riot-API-Golang/constants/summoner_spell"
 )
 
-// Matchlist is an array of strings that represent the match IDs.
-type Matchlist []string
+// Matchlist is an array of match IDs.
+type Matchlist []MatchID
 
 func (m Matchlist) MarshalBinary() ([]byte, error) {
 	return json.Marshal(m)
@@ -68,6 +71,27 @@ func (c *uniqueClient) GetMatchlist(continent continent.Continent, puuid string,
 	return &res, err
 }
 
+// GetMatchlistByTournamentCode returns the IDs of the matches played with a tournament code.
+// This endpoint requires a tournament API key.
+func (c *uniqueClient) GetMatchlistByTournamentCode(continent continent.Continent, tournamentCode string) (*Matchlist, error) {
+	var res Matchlist
+	err := c.dispatchAndUnmarshal(continent, "/lol/match/v5/matches/by-tournament-code", fmt.Sprintf("/%s/ids", url.PathEscape(tournamentCode)), nil, ratelimiter.GetMatchlistByTournamentCode, &res)
+	return &res, err
+}
+
+type MatchReplays struct {
+	MatchFileURLs []string `json:"matchFileURLs"` // Download links to the .rofl replay files
+	Total         int32    `json:"total"`
+}
+
+// GetMatchReplaysByPuuid returns the replay files available for a player's recent matches.
+// Unlike the other match endpoints, replays are served by the player's platform.
+func (c *uniqueClient) GetMatchReplaysByPuuid(r region.Region, puuid string) (*MatchReplays, error) {
+	var res MatchReplays
+	err := c.dispatchAndUnmarshal(r, "/lol/match/v5/matches/by-puuid", fmt.Sprintf("/%s/replays", puuid), nil, ratelimiter.GetMatchReplaysByPuuid, &res)
+	return &res, err
+}
+
 type Match struct {
 	Metadata MatchMetadata `json:"metadata"`
 	Info     MatchInfo     `json:"info"`
@@ -75,8 +99,10 @@ type Match struct {
 
 type MatchMetadata struct {
 	DataVersion  string   `json:"dataVersion"` // ex: 2
-	MatchID      string   `json:"matchId"`     // ex: NA1_1234567890
+	MatchID      MatchID  `json:"matchId"`     // ex: NA1_1234567890
 	Participants []string `json:"participants"`
+
+	Extra map[string]json.RawMessage `json:"-"` // Fields unknown to this package, only filled by DecodeTolerant
 }
 
 type MatchInfo struct {
@@ -95,6 +121,8 @@ type MatchInfo struct {
 	QueueID            queue.ID               `json:"queueId"`    // ex: 420
 	Teams              []MatchInfoTeam        `json:"teams"`
 	TournamentCode     string                 `json:"tournamentCode"`
+
+	Extra map[string]json.RawMessage `json:"-"` // Fields unknown to this package, only filled by DecodeTolerant
 }
 
 type MatchInfoParticipant struct {
@@ -112,14 +140,14 @@ type MatchInfoParticipant struct {
 	ChampionName                   string                          `json:"championName"`
 	ChampionTransform              int16                           `json:"championTransform"`
 	CommandPings                   int16                           `json:"commandPings"`
-	ConsumablesPurchased           int16                           `json:"consumablesPurchased"`
+	ConsumablesPurchased           int32                           `json:"consumablesPurchased"`
 	DamageDealtToBuildings         int32                           `json:"damageDealtToBuildings"`
 	DamageDealtToObjectives        int32                           `json:"damageDealtToObjectives"`
 	DamageDealtToTurrets           int32                           `json:"damageDealtToTurrets"`
 	DamageSelfMitigated            int32                           `json:"damageSelfMitigated"`
 	DangerPings                    int16                           `json:"dangerPings"`
 	Deaths                         int16                           `json:"deaths"`
-	DetectorWardsPlaced            int16                           `json:"detectorWardsPlaced"`
+	DetectorWardsPlaced            int32                           `json:"detectorWardsPlaced"`
 	DoubleKills                    int16                           `json:"doubleKills"`
 	DragonKills                    int16                           `json:"dragonKills"`
 	EligibleForProgression         bool                            `json:"eligibleForProgression"`
@@ -146,7 +174,7 @@ type MatchInfoParticipant struct {
 	Item4                          int32                           `json:"item4"`
 	Item5                          int32                           `json:"item5"`
 	Item6                          int32                           `json:"item6"`
-	ItemsPurchased                 int16                           `json:"itemsPurchased"`
+	ItemsPurchased                 int32                           `json:"itemsPurchased"`
 	KillingSprees                  int16                           `json:"killingSprees"`
 	Kills                          int16                           `json:"kills"`
 	Lane                           string                          `json:"lane"`
@@ -158,7 +186,7 @@ type MatchInfoParticipant struct {
 	MagicDamageDealtToChampions    int32                           `json:"magicDamageDealtToChampions"`
 	MagicDamageTaken               int32                           `json:"magicDamageTaken"`
 	NeedVisionPings                int16                           `json:"needVisionPings"`
-	NeutralMinionsKilled           int16                           `json:"neutralMinionsKilled"`
+	NeutralMinionsKilled           int32                           `json:"neutralMinionsKilled"`
 	NexusKills                     int16                           `json:"nexusKills"`
 	NexusLost                      int16                           `json:"nexusLost"`
 	NexusTakedowns                 int16                           `json:"nexusTakedowns"`
@@ -184,15 +212,15 @@ type MatchInfoParticipant struct {
 	RiotIdGameName                 string                          `json:"riotIdGameName"`
 	RiotIdTagline                  string                          `json:"riotIdTagline"`
 	Role                           string                          `json:"role"`
-	SightWardsBoughtInGame         int16                           `json:"sightWardsBoughtInGame"`
+	SightWardsBoughtInGame         int32                           `json:"sightWardsBoughtInGame"`
 	Spell1Casts                    int32                           `json:"spell1Casts"`
 	Spell2Casts                    int32                           `json:"spell2Casts"`
 	Spell3Casts                    int32                           `json:"spell3Casts"`
 	Spell4Casts                    int32                           `json:"spell4Casts"`
 	SubteamPlacement               int16                           `json:"subteamPlacement"`
-	Summoner1Casts                 int16                           `json:"summoner1Casts"`
+	Summoner1Casts                 int32                           `json:"summoner1Casts"`
 	Summoner1ID                    summoner_spell.ID               `json:"summoner1Id"`
-	Summoner2Casts                 int16                           `json:"summoner2Casts"`
+	Summoner2Casts                 int32                           `json:"summoner2Casts"`
 	Summoner2ID                    summoner_spell.ID               `json:"summoner2Id"`
 	SummonerID                     string                          `json:"summonerId"`
 	SummonerLevel                  int32                           `json:"summonerLevel"`
@@ -201,20 +229,20 @@ type MatchInfoParticipant struct {
 	TeamEarlySurrendered           bool                            `json:"teamEarlySurrendered"`
 	TeamID                         int16                           `json:"teamId"`
 	TeamPosition                   string                          `json:"teamPosition"`
-	TimeCCingOthers                int16                           `json:"timeCCingOthers"`
-	TimePlayed                     int16                           `json:"timePlayed"`
-	TotalAllyJungleMinionsKilled   int16                           `json:"totalAllyJungleMinionsKilled"`
+	TimeCCingOthers                int32                           `json:"timeCCingOthers"`
+	TimePlayed                     int32                           `json:"timePlayed"`
+	TotalAllyJungleMinionsKilled   int32                           `json:"totalAllyJungleMinionsKilled"`
 	TotalDamageDealt               int32                           `json:"totalDamageDealt"`
 	TotalDamageDealtToChampions    int32                           `json:"totalDamageDealtToChampions"`
 	TotalDamageShieldedOnTeammates int32                           `json:"totalDamageShieldedOnTeammates"`
 	TotalDamageTaken               int32                           `json:"totalDamageTaken"`
-	TotalEnemyJungleMinionsKilled  int16                           `json:"totalEnemyJungleMinionsKilled"`
+	TotalEnemyJungleMinionsKilled  int32                           `json:"totalEnemyJungleMinionsKilled"`
 	TotalHeal                      int32                           `json:"totalHeal"`
 	TotalHealsOnTeammates          int32                           `json:"totalHealsOnTeammates"`
-	TotalMinionsKilled             int16                           `json:"totalMinionsKilled"`
-	TotalTimeCCDealt               int16                           `json:"totalTimeCCDealt"`
-	TotalTimeSpentDead             int16                           `json:"totalTimeSpentDead"`
-	TotalUnitsHealed               int16                           `json:"totalUnitsHealed"`
+	TotalMinionsKilled             int32                           `json:"totalMinionsKilled"`
+	TotalTimeCCDealt               int32                           `json:"totalTimeCCDealt"`
+	TotalTimeSpentDead             int32                           `json:"totalTimeSpentDead"`
+	TotalUnitsHealed               int32                           `json:"totalUnitsHealed"`
 	TripleKills                    int16                           `json:"tripleKills"`
 	TrueDamageDealt                int32                           `json:"trueDamageDealt"`
 	TrueDamageDealtToChampions     int32                           `json:"trueDamageDealtToChampions"`
@@ -224,11 +252,13 @@ type MatchInfoParticipant struct {
 	TurretsLost                    int16                           `json:"turretsLost"`
 	UnrealKills                    int16                           `json:"unrealKills"`
 	VisionClearedPings             int16                           `json:"visionClearedPings"`
-	VisionScore                    int16                           `json:"visionScore"`
-	VisionWardsBoughtInGame        int16                           `json:"visionWardsBoughtInGame"`
-	WardsKilled                    int16                           `json:"wardsKilled"`
-	WardsPlaced                    int16                           `json:"wardsPlaced"`
+	VisionScore                    int32                           `json:"visionScore"`
+	VisionWardsBoughtInGame        int32                           `json:"visionWardsBoughtInGame"`
+	WardsKilled                    int32                           `json:"wardsKilled"`
+	WardsPlaced                    int32                           `json:"wardsPlaced"`
 	Win                            bool                            `json:"win"`
+
+	Extra map[string]json.RawMessage `json:"-"` // Fields unknown to this package, only filled by DecodeTolerant
 }
 
 type MatchInfoParticipantChallenges struct {
@@ -326,8 +356,8 @@ type MatchInfoParticipantChallenges struct {
 	SWARM_DefeatMiniBosses                   int16   `json:"SWARM_DefeatMiniBosses"`
 	SWARM_EvolveWeapon                       int16   `json:"SWARM_EvolveWeapon"`
 	SWARM_Have3Passives                      int16   `json:"SWARM_Have3Passives"`
-	SWARM_KillEnemy                          int16   `json:"SWARM_KillEnemy"`
-	SWARM_PickupGold                         int16   `json:"SWARM_PickupGold"`
+	SWARM_KillEnemy                          int32   `json:"SWARM_KillEnemy"`
+	SWARM_PickupGold                         int32   `json:"SWARM_PickupGold"`
 	SWARM_ReachLevel50                       int16   `json:"SWARM_ReachLevel50"`
 	SWARM_Survive15Min                       int16   `json:"SWARM_Survive15Min"`
 	SWARM_WinWith5EvolvedWeapons             int16   `json:"SWARM_WinWith5EvolvedWeapons"`
@@ -354,6 +384,8 @@ type MatchInfoParticipantChallenges struct {
 	WardTakedowns                            int16   `json:"wardTakedowns"`
 	WardTakedownsBefore20M                   int16   `json:"wardTakedownsBefore20M"`
 	WardsGuarded                             int16   `json:"wardsGuarded"`
+
+	Extra map[string]json.RawMessage `json:"-"` // Fields unknown to this package, only filled by DecodeTolerant
 }
 
 type MatchInfoParticipantPerks struct {
@@ -385,6 +417,8 @@ type MatchInfoTeam struct {
 	Objectives MatchInfoTeamObjectives `json:"objectives"`
 	TeamID     int16                   `json:"teamId"`
 	Win        bool                    `json:"win"`
+
+	Extra map[string]json.RawMessage `json:"-"` // Fields unknown to this package, only filled by DecodeTolerant
 }
 
 type MatchInfoTeamBan struct {
@@ -407,6 +441,16 @@ type MatchInfoTeamObjectiveType struct {
 	Kills int16 `json:"kills"`
 }
 
+// Duration returns the length of the game. Matches from before patch 11.20 have no end timestamp and
+// report their duration in milliseconds instead of seconds.
+func (m MatchInfo) Duration() time.Duration {
+	if m.GameEndTimestamp == 0 {
+		return time.Duration(m.GameDuration) * time.Millisecond
+	}
+
+	return time.Duration(m.GameDuration) * time.Second
+}
+
 func (m MatchInfo) MarshalBinary() ([]byte, error) {
 	return json.Marshal(m)
 }
@@ -428,7 +472,7 @@ type MatchTimeline struct {
 
 type MatchTimelineMetadata struct {
 	DataVersion  string   `json:"dataVersion"`
-	MatchID      string   `json:"matchId"`
+	MatchID      MatchID  `json:"matchId"`
 	Participants []string `json:"participants"`
 }
 
@@ -444,10 +488,37 @@ type MatchTimelineParticipant struct {
 	Puuid         string `json:"puuid"`
 }
 
+// ParticipantID returns the participant ID of the player in the timeline.
+func (m MatchTimelineInfo) ParticipantID(puuid string) (int16, bool) {
+	for _, participant := range m.Participants {
+		if participant.Puuid == puuid {
+			return participant.ParticipantID, true
+		}
+	}
+
+	return 0, false
+}
+
+// ParticipantFrames returns the player's participant frame for each frame of the timeline, in order.
+// Frames where the player is missing are left as zero values so indexes match m.Frames.
+func (m MatchTimelineInfo) ParticipantFrames(puuid string) []MatchTimelineParticipantFrame {
+	participantID, ok := m.ParticipantID(puuid)
+	if !ok {
+		return nil
+	}
+
+	frames := make([]MatchTimelineParticipantFrame, len(m.Frames))
+	for i, frame := range m.Frames {
+		frames[i], _ = frame.ParticipantFrames.ByParticipantID(participantID)
+	}
+
+	return frames
+}
+
 type MatchTimelineFrame struct {
-	Timestamp         int32                           `json:"timestamp"`
-	ParticipantFrames []MatchTimelineParticipantFrame `json:"participantFrames"`
-	Events            []interface{}                   `json:"events"`
+	Timestamp         int32                          `json:"timestamp"`
+	ParticipantFrames MatchTimelineParticipantFrames `json:"participantFrames"`
+	Events            []MatchTimelineEvent           `json:"events"`
 }
 
 type MatchTimelineFrameEventType string
@@ -717,6 +788,7 @@ type MatchTimelineEvent_MonsterType string
 const (
 	MatchTimelineEvent_MonsterType_Baron      MatchTimelineEvent_MonsterType = "BARON_NASHOR"
 	MatchTimelineEvent_MonsterType_Dragon     MatchTimelineEvent_MonsterType = "DRAGON"
+	MatchTimelineEvent_MonsterType_Horde      MatchTimelineEvent_MonsterType = "HORDE"
 	MatchTimelineEvent_MonsterType_RiftHerald MatchTimelineEvent_MonsterType = "RIFTHERALD"
 )
 
@@ -742,9 +814,9 @@ const (
 func (m *MatchTimelineFrame) UnmarshalJSON(data []byte) error {
 	// Define a temporary struct with the same fields as MatchTimelineFrame
 	temp := &struct {
-		Timestamp         int32                                    `json:"timestamp"`
-		ParticipantFrames map[string]MatchTimelineParticipantFrame `json:"participantFrames"`
-		Events            []json.RawMessage                        `json:"events"`
+		Timestamp         int32                          `json:"timestamp"`
+		ParticipantFrames MatchTimelineParticipantFrames `json:"participantFrames"`
+		Events            []json.RawMessage              `json:"events"`
 	}{}
 
 	// Unmarshal data into the temporary struct
@@ -764,55 +836,7 @@ func (m *MatchTimelineFrame) UnmarshalJSON(data []byte) error {
 			return err
 		}
 
-		var event interface{}
-		switch typeHolder.Type {
-		case AscendedEvent:
-			event = new(MatchTimelineEvent_AscendedEvent)
-		case BuildingKill:
-			event = new(MatchTimelineEvent_BuildingKill)
-		case CapturePoint:
-			event = new(MatchTimelineEvent_CapturePoint)
-		case ChampionKill:
-			event = new(MatchTimelineEvent_ChampionKill)
-		case ChampionSpecialKill:
-			event = new(MatchTimelineEvent_ChampionSpecialKill)
-		case ChampionTransform:
-			event = new(MatchTimelineEvent_ChampionTransform)
-		case DragonSoulGiven:
-			event = new(MatchTimelineEvent_DragonSoulGiven)
-		case EliteMonsterKill:
-			event = new(MatchTimelineEvent_EliteMonsterKill)
-		case GameEnd:
-			event = new(MatchTimelineEvent_GameEnd)
-		case ItemDestroyed:
-			event = new(MatchTimelineEvent_ItemDestroyed)
-		case ItemPurchased:
-			event = new(MatchTimelineEvent_ItemPurchased)
-		case ItemSold:
-			event = new(MatchTimelineEvent_ItemSold)
-		case ItemUndo:
-			event = new(MatchTimelineEvent_ItemUndo)
-		case LevelUp:
-			event = new(MatchTimelineEvent_LevelUp)
-		case ObjectiveBountyFinish:
-			event = new(MatchTimelineEvent_ObjectiveBountyFinish)
-		case ObjectiveBountyPreStart:
-			event = new(MatchTimelineEvent_ObjectiveBountyPreStart)
-		case PauseEnd:
-			event = new(MatchTimelineEvent_PauseEnd)
-		case PoroKingSummon:
-			event = new(MatchTimelineEvent_PoroKingSummon)
-		case SkillLevelUp:
-			event = new(MatchTimelineEvent_SkillLevelUp)
-		case TurretPlateDestroyed:
-			event = new(MatchTimelineEvent_TurretPlateDestroyed)
-		case WardKill:
-			event = new(MatchTimelineEvent_WardKill)
-		case WardPlaced:
-			event = new(MatchTimelineEvent_WardPlaced)
-		default:
-			continue // Skip unknown event types
-		}
+		event := newMatchTimelineEvent(typeHolder.Type, rawMsg)
 
 		// Unmarshal the event
 		if err := json.Unmarshal(rawMsg, event); err != nil {
@@ -822,22 +846,136 @@ func (m *MatchTimelineFrame) UnmarshalJSON(data []byte) error {
 		m.Events = append(m.Events, event)
 	}
 
-	// Convert map to slice
-	m.ParticipantFrames = make([]MatchTimelineParticipantFrame, 0, len(temp.ParticipantFrames))
-	for _, pf := range temp.ParticipantFrames {
-		m.ParticipantFrames = append(m.ParticipantFrames, pf)
+	m.ParticipantFrames = temp.ParticipantFrames
+
+	return nil
+}
+
+// newMatchTimelineEvent returns an empty event of the type, to decode raw into.
+func newMatchTimelineEvent(eventType MatchTimelineFrameEventType, raw json.RawMessage) MatchTimelineEvent {
+	switch eventType {
+	case AscendedEvent:
+		return new(MatchTimelineEvent_AscendedEvent)
+	case BuildingKill:
+		return new(MatchTimelineEvent_BuildingKill)
+	case CapturePoint:
+		return new(MatchTimelineEvent_CapturePoint)
+	case ChampionKill:
+		return new(MatchTimelineEvent_ChampionKill)
+	case ChampionSpecialKill:
+		return new(MatchTimelineEvent_ChampionSpecialKill)
+	case ChampionTransform:
+		return new(MatchTimelineEvent_ChampionTransform)
+	case DragonSoulGiven:
+		return new(MatchTimelineEvent_DragonSoulGiven)
+	case EliteMonsterKill:
+		return new(MatchTimelineEvent_EliteMonsterKill)
+	case GameEnd:
+		return new(MatchTimelineEvent_GameEnd)
+	case ItemDestroyed:
+		return new(MatchTimelineEvent_ItemDestroyed)
+	case ItemPurchased:
+		return new(MatchTimelineEvent_ItemPurchased)
+	case ItemSold:
+		return new(MatchTimelineEvent_ItemSold)
+	case ItemUndo:
+		return new(MatchTimelineEvent_ItemUndo)
+	case LevelUp:
+		return new(MatchTimelineEvent_LevelUp)
+	case ObjectiveBountyFinish:
+		return new(MatchTimelineEvent_ObjectiveBountyFinish)
+	case ObjectiveBountyPreStart:
+		return new(MatchTimelineEvent_ObjectiveBountyPreStart)
+	case PauseEnd:
+		return new(MatchTimelineEvent_PauseEnd)
+	case PoroKingSummon:
+		return new(MatchTimelineEvent_PoroKingSummon)
+	case SkillLevelUp:
+		return new(MatchTimelineEvent_SkillLevelUp)
+	case TurretPlateDestroyed:
+		return new(MatchTimelineEvent_TurretPlateDestroyed)
+	case WardKill:
+		return new(MatchTimelineEvent_WardKill)
+	case WardPlaced:
+		return new(MatchTimelineEvent_WardPlaced)
+	default:
+		// Keep unknown event types so that new events are not lost
+		return &MatchTimelineEvent_Unknown{Raw: append(json.RawMessage(nil), raw...)}
 	}
+}
+
+// MatchTimelineParticipantFrames is the list of participant frames of a frame, sorted by participant ID.
+type MatchTimelineParticipantFrames []MatchTimelineParticipantFrame
 
+// Need to unmarshal MatchTimelineParticipantFrames because Riot sends an object with keys as participant IDs.
+func (m *MatchTimelineParticipantFrames) UnmarshalJSON(data []byte) error {
+	// Also accept a list, which is how participant frames used to be marshaled
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '[' {
+		var frames []MatchTimelineParticipantFrame
+		if err := json.Unmarshal(data, &frames); err != nil {
+			return err
+		}
+
+		*m = frames
+		m.sort()
+		return nil
+	}
+
+	var temp map[string]MatchTimelineParticipantFrame
+	if err := json.Unmarshal(data, &temp); err != nil {
+		return err
+	}
+
+	frames := make(MatchTimelineParticipantFrames, 0, len(temp))
+	for key, pf := range temp {
+		participantID, err := strconv.ParseInt(key, 10, 16)
+		if err != nil {
+			return fmt.Errorf("invalid participant id %q: %w", key, err)
+		}
+
+		pf.ParticipantID = int16(participantID)
+		frames = append(frames, pf)
+	}
+
+	*m = frames
+	m.sort()
 	return nil
 }
 
+// MarshalJSON writes the participant frames in the same format as Riot, keyed by participant ID.
+func (m MatchTimelineParticipantFrames) MarshalJSON() ([]byte, error) {
+	temp := make(map[string]MatchTimelineParticipantFrame, len(m))
+	for _, pf := range m {
+		temp[strconv.Itoa(int(pf.ParticipantID))] = pf
+	}
+
+	return json.Marshal(temp)
+}
+
+func (m MatchTimelineParticipantFrames) sort() {
+	sort.Slice(m, func(i, j int) bool {
+		return m[i].ParticipantID < m[j].ParticipantID
+	})
+}
+
+// ByParticipantID returns the participant frame of the participant.
+func (m MatchTimelineParticipantFrames) ByParticipantID(participantID int16) (MatchTimelineParticipantFrame, bool) {
+	for _, pf := range m {
+		if pf.ParticipantID == participantID {
+			return pf, true
+		}
+	}
+
+	return MatchTimelineParticipantFrame{}, false
+}
+
 type MatchTimelineParticipantFrame struct {
 	ChampionStats            MatchTimelineChampionStats `json:"championStats"`
 	CurrentGold              int32                      `json:"currentGold"`
 	DamageStats              MatchTimelineDamageStats   `json:"damageStats"`
-	JungleMinionsKilled      int16                      `json:"jungleMinionsKilled"`
+	JungleMinionsKilled      int32                      `json:"jungleMinionsKilled"`
 	Level                    int16                      `json:"level"`
-	MinionsKilled            int16                      `json:"minionsKilled"`
+	MinionsKilled            int32                      `json:"minionsKilled"`
 	ParticipantID            int16                      `json:"participantId"`
 	Position                 MatchTimelinePosition      `json:"position"`
 	TimeEnemySpentControlled int32                      `json:"timeEnemySpentControlled"`
@@ -0,0 +1,94 @@
+package apiclient
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Kinveil/Riot-API-Golang/constants/continent"
+	"github.com/Kinveil/Riot-API-Golang/constants/region"
+)
+
+// MatchID is a Match-v5 match ID made of the platform and game ID, ex: NA1_1234567890
+type MatchID string
+
+// NewMatchID builds the match ID of a game, such as the PlatformID and GameID of an active game.
+func NewMatchID(r region.Region, gameID int64) MatchID {
+	return MatchID(fmt.Sprintf("%s_%d", r, gameID))
+}
+
+// ParseMatchID validates that s is a match ID of a known platform.
+func ParseMatchID(s string) (MatchID, error) {
+	id := MatchID(strings.ToUpper(strings.TrimSpace(s)))
+	if err := id.Validate(); err != nil {
+		return "", err
+	}
+
+	return id, nil
+}
+
+func (m MatchID) String() string {
+	return string(m)
+}
+
+// Validate returns an error if the match ID does not have a known platform prefix and a numeric game ID.
+func (m MatchID) Validate() error {
+	platform, gameID, ok := strings.Cut(string(m), "_")
+	if !ok {
+		return fmt.Errorf("match id %q is missing the platform prefix", string(m))
+	}
+
+	if region.Region(platform).Host() == "" {
+		return fmt.Errorf("match id %q has an unknown platform %q", string(m), platform)
+	}
+
+	if _, err := strconv.ParseInt(gameID, 10, 64); err != nil {
+		return fmt.Errorf("match id %q has an invalid game id", string(m))
+	}
+
+	return nil
+}
+
+// Region returns the platform the match was played on, or an empty region if the match ID is invalid.
+func (m MatchID) Region() region.Region {
+	if m.Validate() != nil {
+		return ""
+	}
+
+	platform, _, _ := strings.Cut(string(m), "_")
+	return region.Region(platform)
+}
+
+// GameID returns the numeric game ID, or 0 if the match ID is invalid.
+func (m MatchID) GameID() int64 {
+	if m.Validate() != nil {
+		return 0
+	}
+
+	_, gameID, _ := strings.Cut(string(m), "_")
+	id, _ := strconv.ParseInt(gameID, 10, 64)
+	return id
+}
+
+// Continent returns the Match-v5 routing value of the match's platform.
+func (m MatchID) Continent() continent.Continent {
+	return m.Region().ContinentMatchV5()
+}
+
+// GetMatchByID returns the match, routing the request to the continent of the match's platform.
+func (c *uniqueClient) GetMatchByID(matchID MatchID) (*Match, error) {
+	if err := matchID.Validate(); err != nil {
+		return nil, err
+	}
+
+	return c.GetMatch(matchID.Continent(), matchID.String())
+}
+
+// GetMatchTimelineByID returns the match timeline, routing the request to the continent of the match's platform.
+func (c *uniqueClient) GetMatchTimelineByID(matchID MatchID) (*MatchTimeline, error) {
+	if err := matchID.Validate(); err != nil {
+		return nil, err
+	}
+
+	return c.GetMatchTimeline(matchID.Continent(), matchID.String())
+}
@@ -0,0 +1,34 @@
+package apiclient
+
+import (
+	"testing"
+
+	"github.com/Kinveil/Riot-API-Golang/constants/continent"
+	"github.com/Kinveil/Riot-API-Golang/constants/region"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMatchID(t *testing.T) {
+	matchID, err := ParseMatchID("NA1_1234567890")
+	assert.NoError(t, err)
+	assert.Equal(t, region.NA1, matchID.Region())
+	assert.Equal(t, int64(1234567890), matchID.GameID())
+	assert.Equal(t, continent.AMERICAS, matchID.Continent())
+
+	matchID, err = ParseMatchID(" sg2_987 ")
+	assert.NoError(t, err)
+	assert.Equal(t, MatchID("SG2_987"), matchID)
+	assert.Equal(t, region.SEA, matchID.Region())
+	assert.Equal(t, continent.SEA, matchID.Continent())
+
+	for _, invalid := range []string{"", "1234567890", "XX1_123", "NA1_", "NA1_abc"} {
+		_, err := ParseMatchID(invalid)
+		assert.Error(t, err, invalid)
+		assert.Equal(t, region.Region(""), MatchID(invalid).Region())
+		assert.Zero(t, MatchID(invalid).GameID())
+	}
+}
+
+func TestNewMatchID(t *testing.T) {
+	assert.Equal(t, MatchID("EUW1_6543210"), NewMatchID(region.EUW1, 6543210))
+}
@@ -0,0 +1,77 @@
+package apiclient
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const timelineInfoJSON = `{
+	"frameInterval": 60000,
+	"gameId": 1234567890,
+	"participants": [
+		{"participantId": 1, "puuid": "puuid-1"},
+		{"participantId": 2, "puuid": "puuid-2"},
+		{"participantId": 10, "puuid": "puuid-10"}
+	],
+	"frames": [
+		{
+			"timestamp": 0,
+			"participantFrames": {
+				"10": {"participantId": 10, "totalGold": 500},
+				"2": {"participantId": 2, "totalGold": 500},
+				"1": {"participantId": 1, "totalGold": 500}
+			},
+			"events": []
+		},
+		{
+			"timestamp": 60000,
+			"participantFrames": {
+				"2": {"participantId": 2, "totalGold": 620},
+				"1": {"participantId": 1, "totalGold": 640},
+				"10": {"participantId": 10, "totalGold": 700}
+			},
+			"events": []
+		}
+	]
+}`
+
+func TestMatchTimelineParticipantFrames(t *testing.T) {
+	var info MatchTimelineInfo
+	assert.NoError(t, json.Unmarshal([]byte(timelineInfoJSON), &info))
+
+	for _, frame := range info.Frames {
+		assert.Equal(t, []int16{1, 2, 10}, []int16{frame.ParticipantFrames[0].ParticipantID, frame.ParticipantFrames[1].ParticipantID, frame.ParticipantFrames[2].ParticipantID})
+	}
+
+	pf, ok := info.Frames[1].ParticipantFrames.ByParticipantID(10)
+	assert.True(t, ok)
+	assert.Equal(t, int32(700), pf.TotalGold)
+
+	_, ok = info.Frames[1].ParticipantFrames.ByParticipantID(5)
+	assert.False(t, ok)
+
+	participantID, ok := info.ParticipantID("puuid-2")
+	assert.True(t, ok)
+	assert.Equal(t, int16(2), participantID)
+
+	frames := info.ParticipantFrames("puuid-1")
+	assert.Len(t, frames, 2)
+	assert.Equal(t, int32(500), frames[0].TotalGold)
+	assert.Equal(t, int32(640), frames[1].TotalGold)
+
+	assert.Nil(t, info.ParticipantFrames("unknown"))
+}
+
+func TestMatchTimelineParticipantFramesRoundTrip(t *testing.T) {
+	var info MatchTimelineInfo
+	assert.NoError(t, json.Unmarshal([]byte(timelineInfoJSON), &info))
+
+	data, err := json.Marshal(info)
+	assert.NoError(t, err)
+
+	var decoded MatchTimelineInfo
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, info.Frames[1].ParticipantFrames, decoded.Frames[1].ParticipantFrames)
+}
@@ -0,0 +1,237 @@
+package apiclient
+
+import (
+	"encoding/json"
+)
+
+// MatchTimelineEvent is implemented by every MatchTimelineEvent_* type.
+// The accessors are named EventType and EventTimestamp because the events already have Type and Timestamp fields.
+type MatchTimelineEvent interface {
+	EventType() MatchTimelineFrameEventType
+	EventTimestamp() int32 // Milliseconds since the start of the game
+}
+
+// MatchTimelineEvent_Unknown holds an event type that this package does not know about yet.
+// The original JSON is kept in Raw so that no data is lost.
+type MatchTimelineEvent_Unknown struct {
+	Timestamp int32                       `json:"timestamp"`
+	Type      MatchTimelineFrameEventType `json:"type"`
+	Raw       json.RawMessage             `json:"-"`
+}
+
+// MarshalJSON returns the original JSON of the event.
+func (e *MatchTimelineEvent_Unknown) MarshalJSON() ([]byte, error) {
+	if e.Raw == nil {
+		return []byte("null"), nil
+	}
+
+	return e.Raw, nil
+}
+
+// Events returns the events of every frame in chronological order.
+func (i MatchTimelineInfo) Events() []MatchTimelineEvent {
+	var events []MatchTimelineEvent
+	for _, frame := range i.Frames {
+		events = append(events, frame.Events...)
+	}
+
+	return events
+}
+
+// EventsOfType returns the events of type T, ex:
+//
+//	kills := apiclient.EventsOfType[*apiclient.MatchTimelineEvent_ChampionKill](timeline.Info.Events())
+func EventsOfType[T MatchTimelineEvent](events []MatchTimelineEvent) []T {
+	var res []T
+	for _, event := range events {
+		if e, ok := event.(T); ok {
+			res = append(res, e)
+		}
+	}
+
+	return res
+}
+
+func (e *MatchTimelineEvent_AscendedEvent) EventType() MatchTimelineFrameEventType {
+	return e.Type
+}
+
+func (e *MatchTimelineEvent_AscendedEvent) EventTimestamp() int32 {
+	return e.Timestamp
+}
+
+func (e *MatchTimelineEvent_BuildingKill) EventType() MatchTimelineFrameEventType {
+	return e.Type
+}
+
+func (e *MatchTimelineEvent_BuildingKill) EventTimestamp() int32 {
+	return e.Timestamp
+}
+
+func (e *MatchTimelineEvent_CapturePoint) EventType() MatchTimelineFrameEventType {
+	return e.Type
+}
+
+func (e *MatchTimelineEvent_CapturePoint) EventTimestamp() int32 {
+	return e.Timestamp
+}
+
+func (e *MatchTimelineEvent_ChampionKill) EventType() MatchTimelineFrameEventType {
+	return e.Type
+}
+
+func (e *MatchTimelineEvent_ChampionKill) EventTimestamp() int32 {
+	return e.Timestamp
+}
+
+func (e *MatchTimelineEvent_ChampionSpecialKill) EventType() MatchTimelineFrameEventType {
+	return e.Type
+}
+
+func (e *MatchTimelineEvent_ChampionSpecialKill) EventTimestamp() int32 {
+	return e.Timestamp
+}
+
+func (e *MatchTimelineEvent_ChampionTransform) EventType() MatchTimelineFrameEventType {
+	return e.Type
+}
+
+func (e *MatchTimelineEvent_ChampionTransform) EventTimestamp() int32 {
+	return e.Timestamp
+}
+
+func (e *MatchTimelineEvent_DragonSoulGiven) EventType() MatchTimelineFrameEventType {
+	return e.Type
+}
+
+func (e *MatchTimelineEvent_DragonSoulGiven) EventTimestamp() int32 {
+	return e.Timestamp
+}
+
+func (e *MatchTimelineEvent_EliteMonsterKill) EventType() MatchTimelineFrameEventType {
+	return e.Type
+}
+
+func (e *MatchTimelineEvent_EliteMonsterKill) EventTimestamp() int32 {
+	return e.Timestamp
+}
+
+func (e *MatchTimelineEvent_GameEnd) EventType() MatchTimelineFrameEventType {
+	return e.Type
+}
+
+func (e *MatchTimelineEvent_GameEnd) EventTimestamp() int32 {
+	return e.Timestamp
+}
+
+func (e *MatchTimelineEvent_ItemDestroyed) EventType() MatchTimelineFrameEventType {
+	return e.Type
+}
+
+func (e *MatchTimelineEvent_ItemDestroyed) EventTimestamp() int32 {
+	return e.Timestamp
+}
+
+func (e *MatchTimelineEvent_ItemPurchased) EventType() MatchTimelineFrameEventType {
+	return e.Type
+}
+
+func (e *MatchTimelineEvent_ItemPurchased) EventTimestamp() int32 {
+	return e.Timestamp
+}
+
+func (e *MatchTimelineEvent_ItemSold) EventType() MatchTimelineFrameEventType {
+	return e.Type
+}
+
+func (e *MatchTimelineEvent_ItemSold) EventTimestamp() int32 {
+	return e.Timestamp
+}
+
+func (e *MatchTimelineEvent_ItemUndo) EventType() MatchTimelineFrameEventType {
+	return e.Type
+}
+
+func (e *MatchTimelineEvent_ItemUndo) EventTimestamp() int32 {
+	return e.Timestamp
+}
+
+func (e *MatchTimelineEvent_LevelUp) EventType() MatchTimelineFrameEventType {
+	return e.Type
+}
+
+func (e *MatchTimelineEvent_LevelUp) EventTimestamp() int32 {
+	return e.Timestamp
+}
+
+func (e *MatchTimelineEvent_ObjectiveBountyFinish) EventType() MatchTimelineFrameEventType {
+	return e.Type
+}
+
+func (e *MatchTimelineEvent_ObjectiveBountyFinish) EventTimestamp() int32 {
+	return e.Timestamp
+}
+
+func (e *MatchTimelineEvent_ObjectiveBountyPreStart) EventType() MatchTimelineFrameEventType {
+	return e.Type
+}
+
+func (e *MatchTimelineEvent_ObjectiveBountyPreStart) EventTimestamp() int32 {
+	return e.Timestamp
+}
+
+func (e *MatchTimelineEvent_PauseEnd) EventType() MatchTimelineFrameEventType {
+	return e.Type
+}
+
+func (e *MatchTimelineEvent_PauseEnd) EventTimestamp() int32 {
+	return e.Timestamp
+}
+
+func (e *MatchTimelineEvent_PoroKingSummon) EventType() MatchTimelineFrameEventType {
+	return e.Type
+}
+
+func (e *MatchTimelineEvent_PoroKingSummon) EventTimestamp() int32 {
+	return e.Timestamp
+}
+
+func (e *MatchTimelineEvent_SkillLevelUp) EventType() MatchTimelineFrameEventType {
+	return e.Type
+}
+
+func (e *MatchTimelineEvent_SkillLevelUp) EventTimestamp() int32 {
+	return e.Timestamp
+}
+
+func (e *MatchTimelineEvent_TurretPlateDestroyed) EventType() MatchTimelineFrameEventType {
+	return e.Type
+}
+
+func (e *MatchTimelineEvent_TurretPlateDestroyed) EventTimestamp() int32 {
+	return e.Timestamp
+}
+
+func (e *MatchTimelineEvent_WardKill) EventType() MatchTimelineFrameEventType {
+	return e.Type
+}
+
+func (e *MatchTimelineEvent_WardKill) EventTimestamp() int32 {
+	return e.Timestamp
+}
+
+func (e *MatchTimelineEvent_WardPlaced) EventType() MatchTimelineFrameEventType {
+	return e.Type
+}
+
+func (e *MatchTimelineEvent_WardPlaced) EventTimestamp() int32 {
+	return e.Timestamp
+}
+
+func (e *MatchTimelineEvent_Unknown) EventType() MatchTimelineFrameEventType {
+	return e.Type
+}
+
+func (e *MatchTimelineEvent_Unknown) EventTimestamp() int32 {
+	return e.Timestamp
+}
@@ -0,0 +1,48 @@
+package apiclient
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const timelineFrameJSON = `{
+	"timestamp": 60000,
+	"participantFrames": {},
+	"events": [
+		{"type": "ITEM_PURCHASED", "timestamp": 1200, "participantId": 3, "itemId": 1055},
+		{"type": "CHAMPION_KILL", "timestamp": 45000, "killerId": 3, "victimId": 8, "position": {"x": 100, "y": 200}},
+		{"type": "FEATS_OF_STRENGTH", "timestamp": 50000, "teamId": 100, "featType": 2},
+		{"type": "ITEM_PURCHASED", "timestamp": 59000, "participantId": 8, "itemId": 2003}
+	]
+}`
+
+func TestMatchTimelineFrameEvents(t *testing.T) {
+	var frame MatchTimelineFrame
+	assert.NoError(t, json.Unmarshal([]byte(timelineFrameJSON), &frame))
+	assert.Len(t, frame.Events, 4)
+
+	for _, event := range frame.Events {
+		assert.NotZero(t, event.EventTimestamp())
+	}
+
+	purchases := EventsOfType[*MatchTimelineEvent_ItemPurchased](frame.Events)
+	assert.Len(t, purchases, 2)
+	assert.Equal(t, int32(1055), purchases[0].ItemID)
+	assert.Equal(t, int16(8), purchases[1].ParticipantID)
+
+	kills := EventsOfType[*MatchTimelineEvent_ChampionKill](frame.Events)
+	assert.Len(t, kills, 1)
+	assert.Equal(t, ChampionKill, kills[0].EventType())
+
+	unknown := EventsOfType[*MatchTimelineEvent_Unknown](frame.Events)
+	assert.Len(t, unknown, 1)
+	assert.Equal(t, MatchTimelineFrameEventType("FEATS_OF_STRENGTH"), unknown[0].EventType())
+	assert.Equal(t, int32(50000), unknown[0].EventTimestamp())
+
+	// The unknown event is written back exactly as it was received
+	data, err := json.Marshal(unknown[0])
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"type": "FEATS_OF_STRENGTH", "timestamp": 50000, "teamId": 100, "featType": 2}`, string(data))
+}
@@ -0,0 +1,137 @@
+package apiclient
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/Kinveil/Riot-API-Golang/constants/continent"
+)
+
+// The maximum number of match IDs Riot returns per matchlist page.
+const matchlistMaxPageSize = 100
+
+// MatchlistIterator pages through every match ID of a player.
+// It uses the context and priority of the client that created it.
+//
+//	it := client.WithPriority(-1).GetMatchlistIterator(continent.AMERICAS, puuid, &apiclient.GetMatchlistOptions{Queue: &q})
+//	for it.Next() {
+//		fmt.Println(it.MatchID())
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type MatchlistIterator struct {
+	client    Client
+	ctx       context.Context // nil when the client's context is unknown, GetMatchlist then reports it
+	continent continent.Continent
+	puuid     string
+	opts      GetMatchlistOptions
+	start     int
+	pageSize  int
+	page      Matchlist
+	index     int
+	lastPage  bool
+	err       error
+}
+
+// GetMatchlistIterator returns an iterator over all match IDs matching opts, newest first.
+// opts.Start is used as the initial offset and opts.Count as the page size (default and maximum 100).
+func (c *uniqueClient) GetMatchlistIterator(continent continent.Continent, puuid string, opts *GetMatchlistOptions) *MatchlistIterator {
+	it := NewMatchlistIterator(c, continent, puuid, opts)
+	it.ctx = c.ctx
+	return it
+}
+
+// NewMatchlistIterator returns an iterator that pages with client.GetMatchlist, so that other implementations of
+// Client, such as test doubles, can implement GetMatchlistIterator.
+func NewMatchlistIterator(client Client, continent continent.Continent, puuid string, opts *GetMatchlistOptions) *MatchlistIterator {
+	it := &MatchlistIterator{
+		client:    client,
+		continent: continent,
+		puuid:     puuid,
+		pageSize:  matchlistMaxPageSize,
+	}
+
+	if opts != nil {
+		it.opts = *opts
+
+		if opts.Start != nil {
+			it.start = int(*opts.Start)
+		}
+
+		if opts.Count != nil && *opts.Count > 0 && *opts.Count < matchlistMaxPageSize {
+			it.pageSize = int(*opts.Count)
+		}
+	}
+
+	return it
+}
+
+// Next advances the iterator to the next match ID, fetching the next page when needed.
+// It returns false when there are no more match IDs or an error occurred.
+func (it *MatchlistIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+
+	it.index++
+	if it.index < len(it.page) {
+		return true
+	}
+
+	if it.lastPage {
+		return false
+	}
+
+	if it.ctx != nil && it.ctx.Err() != nil {
+		it.err = it.ctx.Err()
+		return false
+	}
+
+	// Start is sent as an int16, so the match IDs past it cannot be requested
+	if it.start > math.MaxInt16 {
+		it.err = fmt.Errorf("matchlist offset %d exceeds the maximum start of %d, the remaining match IDs cannot be requested", it.start, math.MaxInt16)
+		return false
+	}
+
+	start := int16(it.start)
+	count := int16(it.pageSize)
+	opts := it.opts
+	opts.Start = &start
+	opts.Count = &count
+
+	page, err := it.client.GetMatchlist(it.continent, it.puuid, &opts)
+	if err != nil {
+		it.err = err
+		return false
+	}
+
+	it.page = *page
+	it.index = 0
+	it.start += len(it.page)
+	it.lastPage = len(it.page) < it.pageSize
+
+	return len(it.page) > 0
+}
+
+// MatchID returns the current match ID. It is only valid after a call to Next returned true.
+func (it *MatchlistIterator) MatchID() MatchID {
+	return it.page[it.index]
+}
+
+// Err returns the error that stopped the iteration, if any. The iteration also stops with an error when the offset
+// exceeds the int16 start of the API, so that a truncated matchlist is not mistaken for a complete one.
+func (it *MatchlistIterator) Err() error {
+	return it.err
+}
+
+// All consumes the iterator and returns the remaining match IDs.
+func (it *MatchlistIterator) All() (Matchlist, error) {
+	var res Matchlist
+	for it.Next() {
+		res = append(res, it.MatchID())
+	}
+
+	return res, it.Err()
+}
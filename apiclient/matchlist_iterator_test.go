@@ -0,0 +1,87 @@
+package apiclient
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/Kinveil/Riot-API-Golang/apiclient/ratelimiter"
+	"github.com/Kinveil/Riot-API-Golang/constants/continent"
+	"github.com/Kinveil/Riot-API-Golang/constants/queue"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchlistIterator(t *testing.T) {
+	var starts []string
+	client := newTestClient(t, func(req *ratelimiter.APIRequest) (int, interface{}) {
+		u, _ := url.Parse(req.URL)
+		starts = append(starts, u.Query().Get("start"))
+		assert.Equal(t, "420", u.Query().Get("queue"))
+
+		start, _ := strconv.Atoi(u.Query().Get("start"))
+		count, _ := strconv.Atoi(u.Query().Get("count"))
+
+		var page Matchlist
+		for i := start; i < start+count && i < 250; i++ {
+			page = append(page, MatchID(fmt.Sprintf("NA1_%d", i)))
+		}
+
+		return http.StatusOK, page
+	})
+
+	q := queue.RankedSolo5x5
+	matchIDs, err := client.GetMatchlistIterator(continent.AMERICAS, "puuid", &GetMatchlistOptions{Queue: &q}).All()
+	assert.NoError(t, err)
+	assert.Len(t, matchIDs, 250)
+	assert.Equal(t, MatchID("NA1_0"), matchIDs[0])
+	assert.Equal(t, MatchID("NA1_249"), matchIDs[249])
+	assert.Equal(t, []string{"0", "100", "200"}, starts)
+}
+
+func TestMatchlistIteratorStopsOnEmptyPage(t *testing.T) {
+	requests := 0
+	client := newTestClient(t, func(req *ratelimiter.APIRequest) (int, interface{}) {
+		requests++
+		if requests == 1 {
+			return http.StatusOK, Matchlist{"NA1_1", "NA1_2"}
+		}
+
+		return http.StatusOK, Matchlist{}
+	})
+
+	count := int16(2)
+	matchIDs, err := client.GetMatchlistIterator(continent.AMERICAS, "puuid", &GetMatchlistOptions{Count: &count}).All()
+	assert.NoError(t, err)
+	assert.Equal(t, Matchlist{"NA1_1", "NA1_2"}, matchIDs)
+	assert.Equal(t, 2, requests)
+}
+
+func TestMatchlistIteratorContextCancelled(t *testing.T) {
+	client := newTestClient(t, func(req *ratelimiter.APIRequest) (int, interface{}) {
+		return http.StatusOK, Matchlist{}
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	<-ctx.Done()
+
+	it := client.WithContext(ctx).GetMatchlistIterator(continent.AMERICAS, "puuid", nil)
+	assert.False(t, it.Next())
+	assert.ErrorIs(t, it.Err(), context.DeadlineExceeded)
+}
+
+func TestMatchlistIteratorOffsetLimit(t *testing.T) {
+	client := newTestClient(t, func(req *ratelimiter.APIRequest) (int, interface{}) {
+		return http.StatusOK, Matchlist{"NA1_1", "NA1_2"}
+	})
+
+	start, count := int16(math.MaxInt16-1), int16(2)
+	matchIDs, err := client.GetMatchlistIterator(continent.AMERICAS, "puuid", &GetMatchlistOptions{Start: &start, Count: &count}).All()
+	assert.ErrorContains(t, err, "exceeds the maximum start")
+	assert.Equal(t, Matchlist{"NA1_1", "NA1_2"}, matchIDs)
+}
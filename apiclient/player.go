@@ -0,0 +1,101 @@
+package apiclient
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/Kinveil/Riot-API-Golang/constants/region"
+	"golang.org/x/text/unicode/norm"
+)
+
+// RiotID is a player's "GameName#TagLine" identifier.
+type RiotID struct {
+	GameName string
+	TagLine  string
+}
+
+func (r RiotID) String() string {
+	return r.GameName + "#" + r.TagLine
+}
+
+// ParseRiotID parses and normalizes a Riot ID such as "Mighty Junior #NA1".
+// Surrounding and repeated whitespace is removed and the text is NFC normalized, as players often copy
+// their Riot ID with extra spaces. The game name must be 3 to 16 characters and the tag line 3 to 5.
+func ParseRiotID(s string) (RiotID, error) {
+	separator := strings.LastIndex(s, "#")
+	if separator == -1 {
+		return RiotID{}, fmt.Errorf("riot id %q is missing a tag line", s)
+	}
+
+	riotID := RiotID{
+		GameName: normalizeRiotIDPart(s[:separator]),
+		TagLine:  normalizeRiotIDPart(s[separator+1:]),
+	}
+
+	if length := utf8.RuneCountInString(riotID.GameName); length < 3 || length > 16 {
+		return RiotID{}, fmt.Errorf("riot id %q has an invalid game name length", s)
+	}
+
+	if length := utf8.RuneCountInString(riotID.TagLine); length < 3 || length > 5 {
+		return RiotID{}, fmt.Errorf("riot id %q has an invalid tag line length", s)
+	}
+
+	for _, r := range riotID.TagLine {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			return RiotID{}, fmt.Errorf("riot id %q has an invalid tag line", s)
+		}
+	}
+
+	return riotID, nil
+}
+
+func normalizeRiotIDPart(s string) string {
+	return norm.NFC.String(strings.Join(strings.Fields(s), " "))
+}
+
+// Player is the combined profile of a player on a League of Legends platform.
+type Player struct {
+	RiotID        RiotID
+	Region        region.Region
+	Account       Account
+	Summoner      Summoner
+	LeagueEntries []LeagueEntry
+}
+
+// ResolvePlayer looks up a player by Riot ID ("Name#TAG") on the given platform.
+// The account is fetched from the region's Account-v1 continent, followed by the summoner and league entries from the platform.
+func (c *uniqueClient) ResolvePlayer(ctx context.Context, r region.Region, riotID string) (*Player, error) {
+	id, err := ParseRiotID(riotID)
+	if err != nil {
+		return nil, err
+	}
+
+	client := c.WithContext(ctx)
+
+	account, err := client.GetAccountByRiotID(r.ContinentAccountV1(), id.GameName, id.TagLine)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account %s: %w", id, err)
+	}
+
+	summoner, err := client.GetSummonerByPuuid(r, account.Puuid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get summoner %s: %w", id, err)
+	}
+
+	leagueEntries, err := client.GetLeagueEntriesByPuuid(r, account.Puuid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get league entries %s: %w", id, err)
+	}
+
+	return &Player{
+		// Use the account's casing rather than what was typed
+		RiotID:        RiotID{GameName: account.GameName, TagLine: account.TagLine},
+		Region:        r,
+		Account:       *account,
+		Summoner:      *summoner,
+		LeagueEntries: leagueEntries,
+	}, nil
+}
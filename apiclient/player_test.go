@@ -0,0 +1,28 @@
+package apiclient
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRiotID(t *testing.T) {
+	riotID, err := ParseRiotID("Mighty Junior#NA1")
+	assert.NoError(t, err)
+	assert.Equal(t, RiotID{GameName: "Mighty Junior", TagLine: "NA1"}, riotID)
+	assert.Equal(t, "Mighty Junior#NA1", riotID.String())
+
+	riotID, err = ParseRiotID("  Mighty   Junior #  NA1 ")
+	assert.NoError(t, err)
+	assert.Equal(t, RiotID{GameName: "Mighty Junior", TagLine: "NA1"}, riotID)
+
+	// Decomposed "é" is normalized to its composed form
+	riotID, err = ParseRiotID("Ame\u0301lie#EUW")
+	assert.NoError(t, err)
+	assert.Equal(t, "Amélie", riotID.GameName)
+
+	for _, invalid := range []string{"Mighty Junior", "ab#NA1", "Mighty Junior#N1", "Mighty Junior#NA1234", "Mighty Junior#N-A1", "#NA1"} {
+		_, err := ParseRiotID(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
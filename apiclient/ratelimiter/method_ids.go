@@ -8,14 +8,21 @@ func (m MethodID) String() string {
 
 const (
 	// ----- Account API -----
-	GetAccountByPuuid  MethodID = "GetAccountByPuuid"
-	GetAccountByRiotID MethodID = "GetAccountByRiotID"
+	GetAccountByPuuid       MethodID = "GetAccountByPuuid"
+	GetAccountByRiotID      MethodID = "GetAccountByRiotID"
+	GetAccountByAccessToken MethodID = "GetAccountByAccessToken"
+	GetAccountActiveShard   MethodID = "GetAccountActiveShard"
+	GetAccountActiveRegion  MethodID = "GetAccountActiveRegion"
 
 	// ----- Champion Mastery API -----
 	GetChampionMasteriesBySummonerID            MethodID = "GetChampionMasteriesBySummonerID"
 	GetChampionMasteryBySummonerIDAndChampionID MethodID = "GetChampionMasteryBySummonerIDAndChampionID"
 	GetChampionMasteriesTopBySummonerID         MethodID = "GetChampionMasteriesTopBySummonerID"
 	GetChampionMasteryScoreTotalBySummonerID    MethodID = "GetChampionMasteryScoreTotalBySummonerID"
+	GetChampionMasteriesByPuuid                 MethodID = "GetChampionMasteriesByPuuid"
+	GetChampionMasteryByPuuidAndChampionID      MethodID = "GetChampionMasteryByPuuidAndChampionID"
+	GetChampionMasteriesTopByPuuid              MethodID = "GetChampionMasteriesTopByPuuid"
+	GetChampionMasteryScoreTotalByPuuid         MethodID = "GetChampionMasteryScoreTotalByPuuid"
 
 	// ----- Champion API -----
 	GetChampionRotations MethodID = "GetChampionRotations"
@@ -51,18 +58,27 @@ const (
 	// ----- LOL Status API -----
 	GetStatusPlatformData MethodID = "GetStatusPlatformData"
 
+	// ----- LOR Deck API -----
+	GetLorDecks MethodID = "GetLorDecks"
+
+	// ----- LOR Inventory API -----
+	GetLorInventoryCards MethodID = "GetLorInventoryCards"
+
 	// ----- Match API -----
-	GetMatchlist     MethodID = "GetMatchlist"
-	GetMatch         MethodID = "GetMatch"
-	GetMatchTimeline MethodID = "GetMatchTimeline"
+	GetMatchlist                 MethodID = "GetMatchlist"
+	GetMatchlistByTournamentCode MethodID = "GetMatchlistByTournamentCode"
+	GetMatch                     MethodID = "GetMatch"
+	GetMatchTimeline             MethodID = "GetMatchTimeline"
+	GetMatchReplaysByPuuid       MethodID = "GetMatchReplaysByPuuid"
 
 	// ----- Spectator API -----
 	GetSpectatorActiveGameByPuuid MethodID = "GetSpectatorActiveGameBySummonerPuuid"
 	GetSpectatorFeaturedGames     MethodID = "GetSpectatorFeaturedGames"
 
 	// ----- Summoner API -----
-	GetSummonerByRsoPuuid   MethodID = "GetSummonerByRsoPuuid"
-	GetSummonerByAccountID  MethodID = "GetSummonerByAccountID"
-	GetSummonerByPuuid      MethodID = "GetSummonerByPuuid"
-	GetSummonerBySummonerID MethodID = "GetSummonerBySummonerID"
+	GetSummonerByRsoPuuid    MethodID = "GetSummonerByRsoPuuid"
+	GetSummonerByAccountID   MethodID = "GetSummonerByAccountID"
+	GetSummonerByPuuid       MethodID = "GetSummonerByPuuid"
+	GetSummonerBySummonerID  MethodID = "GetSummonerBySummonerID"
+	GetSummonerByAccessToken MethodID = "GetSummonerByAccessToken"
 )
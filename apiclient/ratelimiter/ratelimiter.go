@@ -61,6 +61,11 @@ func (rl *RateLimiter) SetUsageConservation(conserveUsage ConserveUsage) error {
 	return nil
 }
 
+// SetHTTPClient sets the client used to send requests, such as one with a custom transport.
+func (rl *RateLimiter) SetHTTPClient(httpClient *http.Client) {
+	rl.httpClient = httpClient
+}
+
 func (rl *RateLimiter) SetAPIKey(apiKey string) {
 	rl.apiKey = apiKey
 }
@@ -2,7 +2,6 @@ package ratelimiter
 
 import (
 	"context"
-	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
@@ -12,14 +11,15 @@ import (
 )
 
 type APIRequest struct {
-	Context  context.Context
-	Priority int
-	Region   string
-	MethodID MethodID
-	URL      string
-	Response chan<- *http.Response
-	Error    chan<- error
-	Retries  int
+	Context     context.Context
+	Priority    int
+	Region      string
+	MethodID    MethodID
+	URL         string
+	AccessToken string
+	Response    chan<- *http.Response
+	Error       chan<- error
+	Retries     int
 }
 
 type RateLimit struct {
@@ -30,8 +30,8 @@ type RateLimit struct {
 }
 
 func (rl *RateLimiter) handleRequest(req *APIRequest) {
-	regionLimiter := rl.getRegionLimiter(req.Region)
-	methodLimiter := rl.getMethodLimiter(req.Region + req.MethodID.String())
+	regionLimiter := rl.getRegionLimiter(req.limiterKey())
+	methodLimiter := rl.getMethodLimiter(req.limiterKey() + req.MethodID.String())
 
 	isRetryRequest := req.Retries > 0
 	if err := rl.waitForLimiters(req.Context, req.Priority, regionLimiter, methodLimiter, isRetryRequest); err != nil {
@@ -72,10 +72,26 @@ func (rl *RateLimiter) createHTTPRequest(req *APIRequest) (*http.Request, error)
 		return nil, err
 	}
 
-	httpRequest.Header.Set("X-Riot-Token", rl.apiKey)
+	if req.AccessToken != "" {
+		httpRequest.Header.Set("Authorization", "Bearer "+req.AccessToken)
+	} else {
+		httpRequest.Header.Set("X-Riot-Token", rl.apiKey)
+	}
+
 	return httpRequest, nil
 }
 
+// limiterKey returns the key used to look up the request's limiters.
+// Requests authenticated with an RSO access token are counted against the RSO client's
+// limits rather than the API key's, so they are kept in separate limiters.
+func (req *APIRequest) limiterKey() string {
+	if req.AccessToken != "" {
+		return "RSO-" + req.Region
+	}
+
+	return req.Region
+}
+
 func (rl *RateLimiter) handleHTTPResponse(req *APIRequest, resp *http.Response, regionLimiter, methodLimiter *RateLimit) {
 	if resp.StatusCode == http.StatusOK {
 		req.Response <- resp
@@ -114,9 +130,8 @@ func (rl *RateLimiter) handleHTTPResponse(req *APIRequest, resp *http.Response,
 		return
 	}
 
-	// Close the response body and send an error to the channel. Do not know how to handle this response
-	resp.Body.Close()
-	req.Error <- fmt.Errorf("received unexpected status code %d (%s)", resp.StatusCode, req.URL)
+	// Send the response to the channel so that the client can map its status code to an error
+	req.Response <- resp
 	rl.releaseLimitersAfterDelay(regionLimiter, methodLimiter, 15*time.Second)
 }
 
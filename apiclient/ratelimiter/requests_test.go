@@ -0,0 +1,33 @@
+package ratelimiter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateHTTPRequestUsesAPIKey(t *testing.T) {
+	rl := NewRateLimiter(nil, "RGAPI-test")
+
+	req, err := rl.createHTTPRequest(&APIRequest{URL: "https://na1.api.riotgames.com/lol/summoner/v4/summoners/by-puuid/abc"})
+	assert.NoError(t, err)
+	assert.Equal(t, "RGAPI-test", req.Header.Get("X-Riot-Token"))
+	assert.Empty(t, req.Header.Get("Authorization"))
+}
+
+func TestCreateHTTPRequestUsesAccessToken(t *testing.T) {
+	rl := NewRateLimiter(nil, "RGAPI-test")
+
+	req, err := rl.createHTTPRequest(&APIRequest{URL: "https://na1.api.riotgames.com/lol/summoner/v4/summoners/me", AccessToken: "token"})
+	assert.NoError(t, err)
+	assert.Equal(t, "Bearer token", req.Header.Get("Authorization"))
+	assert.Empty(t, req.Header.Get("X-Riot-Token"))
+}
+
+func TestLimiterKeySeparatesAccessTokenRequests(t *testing.T) {
+	apiKeyRequest := &APIRequest{Region: "NA1"}
+	accessTokenRequest := &APIRequest{Region: "NA1", AccessToken: "token"}
+
+	assert.Equal(t, "NA1", apiKeyRequest.limiterKey())
+	assert.NotEqual(t, apiKeyRequest.limiterKey(), accessTokenRequest.limiterKey())
+}
@@ -0,0 +1,23 @@
+package apiclient
+
+import "net/http"
+
+// RawResponse is the response returned by Riot, before decoding.
+type RawResponse struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+// clone returns a deep copy of the response, or nil if r is nil.
+func (r *RawResponse) clone() *RawResponse {
+	if r == nil {
+		return nil
+	}
+
+	return &RawResponse{
+		StatusCode: r.StatusCode,
+		Header:     r.Header.Clone(),
+		Body:       append([]byte(nil), r.Body...),
+	}
+}
@@ -0,0 +1,83 @@
+package apiclient
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Kinveil/Riot-API-Golang/apiclient/ratelimiter"
+	"github.com/Kinveil/Riot-API-Golang/constants/continent"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithRaw(t *testing.T) {
+	body := json.RawMessage(`{"metadata":{"matchId":"NA1_1"},"info":{"gameId":1}}`)
+
+	requests := 0
+	client := newTestClient(t, func(req *ratelimiter.APIRequest) (int, interface{}) {
+		requests++
+		if req.URL == continent.AMERICAS.Host()+"/lol/match/v5/matches/NA1_404" {
+			return http.StatusNotFound, json.RawMessage(`{"status":{"status_code":404}}`)
+		}
+
+		return http.StatusOK, body
+	})
+
+	cached := client.WithCache(time.Minute)
+
+	// Typed responses are cached without their raw body
+	_, err := cached.GetMatch(continent.AMERICAS, "NA1_1")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, requests)
+
+	var raw RawResponse
+	match, err := cached.WithRaw(&raw).GetMatch(continent.AMERICAS, "NA1_1")
+	if assert.NoError(t, err) {
+		assert.Equal(t, int64(1), match.Info.GameID)
+		assert.Equal(t, http.StatusOK, raw.StatusCode)
+		assert.Equal(t, []byte(body), raw.Body)
+	}
+	assert.Equal(t, 2, requests)
+
+	// The raw body is now cached as well
+	var cachedRaw RawResponse
+	_, err = cached.WithRaw(&cachedRaw).GetMatch(continent.AMERICAS, "NA1_1")
+	assert.NoError(t, err)
+	assert.Equal(t, raw, cachedRaw)
+	assert.Equal(t, 2, requests)
+
+	var notFound RawResponse
+	_, err = client.WithRaw(&notFound).GetMatch(continent.AMERICAS, "NA1_404")
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.Equal(t, http.StatusNotFound, notFound.StatusCode)
+	assert.JSONEq(t, `{"status":{"status_code":404}}`, string(notFound.Body))
+
+	// Derived clients share raw
+	var shared RawResponse
+	derived := client.WithRaw(&shared).WithPriority(1)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			derived.GetMatch(continent.AMERICAS, "NA1_1")
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, []byte(body), shared.Body)
+}
+
+func TestWithRawStatusError(t *testing.T) {
+	client := newRateLimitedTestClient(t, func(req *http.Request) (int, string) {
+		return http.StatusNotFound, `{"status":{"status_code":404}}`
+	})
+
+	var raw RawResponse
+	_, err := client.WithRaw(&raw).GetMatch(continent.AMERICAS, "NA1_404")
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.Equal(t, http.StatusNotFound, raw.StatusCode)
+	assert.JSONEq(t, `{"status":{"status_code":404}}`, string(raw.Body))
+}
@@ -48,3 +48,15 @@ func (c *uniqueClient) GetSummonerBySummonerID(r region.Region, summonerID strin
 	err := c.dispatchAndUnmarshal(r, "/lol/summoner/v4/summoners", fmt.Sprintf("/%s", summonerID), nil, ratelimiter.GetSummonerBySummonerID, &res)
 	return &res, err
 }
+
+// GetSummonerByAccessToken returns the summoner of the player who owns the RSO access token.
+// The client must be created with WithAccessToken.
+func (c *uniqueClient) GetSummonerByAccessToken(r region.Region) (*Summoner, error) {
+	if err := c.requireAccessToken(); err != nil {
+		return nil, err
+	}
+
+	var res Summoner
+	err := c.dispatchAndUnmarshal(r, "/lol/summoner/v4/summoners/me", "", nil, ratelimiter.GetSummonerByAccessToken, &res)
+	return &res, err
+}
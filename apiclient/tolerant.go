@@ -0,0 +1,497 @@
+package apiclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// DecodeWarning is a field that could not be decoded exactly by DecodeTolerant.
+type DecodeWarning struct {
+	Path    string // ex: info.participants[3].challenges.killParticipation
+	Message string
+}
+
+func (w DecodeWarning) String() string {
+	return fmt.Sprintf("%s: %s", w.Path, w.Message)
+}
+
+// The name of the struct field that receives the fields unknown to the struct.
+const extraFieldName = "Extra"
+
+var (
+	rawMessageType        = reflect.TypeOf(json.RawMessage{})
+	unmarshalerType       = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	timelineFrameType     = reflect.TypeOf(MatchTimelineFrame{})
+	participantFramesType = reflect.TypeOf(MatchTimelineParticipantFrames{})
+)
+
+// DecodeTolerant decodes JSON into dest like json.Unmarshal, but does not fail when a value does not fit its field.
+// Integers that overflow are clamped, floats sent to integer fields are truncated, and values of the wrong type
+// are left empty. Each of these produces a warning. Object fields that the struct does not declare are kept in
+// its Extra field if it has one of type map[string]json.RawMessage.
+// Other types with their own UnmarshalJSON are decoded by it, except the frames of match timelines whose
+// participant frames and events are decoded tolerantly as well.
+// An error is only returned if the data is not valid JSON or dest is not a pointer.
+func DecodeTolerant(data []byte, dest interface{}) ([]DecodeWarning, error) {
+	if !json.Valid(data) {
+		return nil, fmt.Errorf("invalid JSON")
+	}
+
+	destValue := reflect.ValueOf(dest)
+	if destValue.Kind() != reflect.Ptr || destValue.IsNil() {
+		return nil, fmt.Errorf("destination must be a non-nil pointer")
+	}
+
+	d := &tolerantDecoder{}
+	d.decode("", json.RawMessage(data), destValue.Elem())
+	return d.warnings, nil
+}
+
+type tolerantDecoder struct {
+	warnings []DecodeWarning
+}
+
+func (d *tolerantDecoder) warn(path string, format string, args ...interface{}) {
+	d.warnings = append(d.warnings, DecodeWarning{Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+func (d *tolerantDecoder) decode(path string, data json.RawMessage, v reflect.Value) {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		return
+	}
+
+	// Timeline frames decode themselves, but are decoded here so that their events and participant frames are tolerant too
+	if v.CanAddr() {
+		switch v.Type() {
+		case timelineFrameType:
+			d.decodeTimelineFrame(path, data, v.Addr().Interface().(*MatchTimelineFrame))
+			return
+		case participantFramesType:
+			d.decodeParticipantFrames(path, data, v.Addr().Interface().(*MatchTimelineParticipantFrames))
+			return
+		}
+	}
+
+	// Types with their own decoding are trusted to handle their data
+	if v.CanAddr() && v.Addr().Type().Implements(unmarshalerType) && v.Type() != rawMessageType {
+		if err := v.Addr().Interface().(json.Unmarshaler).UnmarshalJSON(data); err != nil {
+			d.warn(path, "%v", err)
+		}
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		d.decode(path, data, v.Elem())
+	case reflect.Struct:
+		d.decodeStruct(path, data, v)
+	case reflect.Map:
+		d.decodeMap(path, data, v)
+	case reflect.Slice:
+		if v.Type() == rawMessageType {
+			v.SetBytes(append([]byte(nil), data...))
+			return
+		}
+		d.decodeSlice(path, data, v)
+	case reflect.Array:
+		d.decodeSlice(path, data, v)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		d.decodeInt(path, data, v)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		d.decodeUint(path, data, v)
+	case reflect.Float32, reflect.Float64:
+		d.decodeFloat(path, data, v)
+	case reflect.Bool:
+		d.decodeBool(path, data, v)
+	case reflect.String:
+		d.decodeString(path, data, v)
+	default:
+		ptr := reflect.New(v.Type())
+		if err := json.Unmarshal(data, ptr.Interface()); err != nil {
+			d.warn(path, "%v", err)
+			return
+		}
+		v.Set(ptr.Elem())
+	}
+}
+
+func (d *tolerantDecoder) decodeStruct(path string, data json.RawMessage, v reflect.Value) {
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(data, &object); err != nil {
+		d.warn(path, "expected an object, got %s", describe(data))
+		return
+	}
+
+	fields := structFields(v)
+
+	var extra reflect.Value
+	if f := v.FieldByName(extraFieldName); f.IsValid() && f.Type() == reflect.TypeOf(map[string]json.RawMessage{}) {
+		extra = f
+	}
+
+	for key, value := range object {
+		field, ok := fields[key]
+		if !ok {
+			for name, f := range fields {
+				if strings.EqualFold(name, key) {
+					field, ok = f, true
+					break
+				}
+			}
+		}
+
+		if ok {
+			d.decode(joinPath(path, key), value, v.FieldByIndex(field))
+			continue
+		}
+
+		if extra.IsValid() {
+			if extra.IsNil() {
+				extra.Set(reflect.MakeMap(extra.Type()))
+			}
+			extra.SetMapIndex(reflect.ValueOf(key), reflect.ValueOf(value))
+		}
+	}
+}
+
+// decodeTimelineFrame decodes a frame like MatchTimelineFrame.UnmarshalJSON.
+func (d *tolerantDecoder) decodeTimelineFrame(path string, data json.RawMessage, frame *MatchTimelineFrame) {
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(data, &object); err != nil {
+		d.warn(path, "expected an object, got %s", describe(data))
+		return
+	}
+
+	if timestamp, ok := object["timestamp"]; ok {
+		d.decode(joinPath(path, "timestamp"), timestamp, reflect.ValueOf(&frame.Timestamp).Elem())
+	}
+
+	if participantFrames, ok := object["participantFrames"]; ok {
+		d.decode(joinPath(path, "participantFrames"), participantFrames, reflect.ValueOf(&frame.ParticipantFrames).Elem())
+	}
+
+	eventsPath := joinPath(path, "events")
+
+	var events []json.RawMessage
+	if raw, ok := object["events"]; ok {
+		if err := json.Unmarshal(raw, &events); err != nil {
+			d.warn(eventsPath, "expected an array, got %s", describe(raw))
+		}
+	}
+
+	for i, raw := range events {
+		eventPath := fmt.Sprintf("%s[%d]", eventsPath, i)
+
+		var typeHolder struct {
+			Type MatchTimelineFrameEventType `json:"type"`
+		}
+		if err := json.Unmarshal(raw, &typeHolder); err != nil {
+			d.warn(eventPath, "event without a valid type")
+			continue
+		}
+
+		event := newMatchTimelineEvent(typeHolder.Type, raw)
+		d.decode(eventPath, raw, reflect.ValueOf(event).Elem())
+		frame.Events = append(frame.Events, event)
+	}
+}
+
+// decodeParticipantFrames decodes participant frames like MatchTimelineParticipantFrames.UnmarshalJSON.
+func (d *tolerantDecoder) decodeParticipantFrames(path string, data json.RawMessage, frames *MatchTimelineParticipantFrames) {
+	// Also accept a list, which is how participant frames used to be marshaled
+	if data[0] == '[' {
+		var list []MatchTimelineParticipantFrame
+		d.decodeSlice(path, data, reflect.ValueOf(&list).Elem())
+		*frames = list
+		frames.sort()
+		return
+	}
+
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(data, &object); err != nil {
+		d.warn(path, "expected an object, got %s", describe(data))
+		return
+	}
+
+	decoded := make(MatchTimelineParticipantFrames, 0, len(object))
+	for key, value := range object {
+		participantID, err := strconv.ParseInt(key, 10, 16)
+		if err != nil {
+			d.warn(joinPath(path, key), "invalid participant id")
+			continue
+		}
+
+		var frame MatchTimelineParticipantFrame
+		d.decode(joinPath(path, key), value, reflect.ValueOf(&frame).Elem())
+		frame.ParticipantID = int16(participantID)
+		decoded = append(decoded, frame)
+	}
+
+	*frames = decoded
+	frames.sort()
+}
+
+// structFields returns the index of each field by its JSON name, following the encoding/json tag rules.
+func structFields(v reflect.Value) map[string][]int {
+	fields := make(map[string][]int)
+
+	var collect func(t reflect.Type, index []int)
+	collect = func(t reflect.Type, index []int) {
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			fieldIndex := append(append([]int(nil), index...), i)
+
+			tag := f.Tag.Get("json")
+			if tag == "-" {
+				continue
+			}
+
+			name, _, _ := strings.Cut(tag, ",")
+			if f.Anonymous && name == "" && f.Type.Kind() == reflect.Struct {
+				collect(f.Type, fieldIndex)
+				continue
+			}
+
+			if !f.IsExported() {
+				continue
+			}
+
+			if name == "" {
+				name = f.Name
+			}
+
+			if _, ok := fields[name]; !ok {
+				fields[name] = fieldIndex
+			}
+		}
+	}
+
+	collect(v.Type(), nil)
+	return fields
+}
+
+func (d *tolerantDecoder) decodeMap(path string, data json.RawMessage, v reflect.Value) {
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(data, &object); err != nil {
+		d.warn(path, "expected an object, got %s", describe(data))
+		return
+	}
+
+	if v.IsNil() {
+		v.Set(reflect.MakeMap(v.Type()))
+	}
+
+	keyType := v.Type().Key()
+	for key, value := range object {
+		keyValue := reflect.New(keyType).Elem()
+		switch keyType.Kind() {
+		case reflect.String:
+			keyValue.SetString(key)
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			n, err := strconv.ParseInt(key, 10, keyType.Bits())
+			if err != nil {
+				d.warn(joinPath(path, key), "invalid map key")
+				continue
+			}
+			keyValue.SetInt(n)
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			n, err := strconv.ParseUint(key, 10, keyType.Bits())
+			if err != nil {
+				d.warn(joinPath(path, key), "invalid map key")
+				continue
+			}
+			keyValue.SetUint(n)
+		default:
+			d.warn(path, "unsupported map key type %s", keyType)
+			return
+		}
+
+		elem := reflect.New(v.Type().Elem()).Elem()
+		d.decode(joinPath(path, key), value, elem)
+		v.SetMapIndex(keyValue, elem)
+	}
+}
+
+func (d *tolerantDecoder) decodeSlice(path string, data json.RawMessage, v reflect.Value) {
+	var array []json.RawMessage
+	if err := json.Unmarshal(data, &array); err != nil {
+		d.warn(path, "expected an array, got %s", describe(data))
+		return
+	}
+
+	if v.Kind() == reflect.Slice {
+		v.Set(reflect.MakeSlice(v.Type(), len(array), len(array)))
+	} else if len(array) > v.Len() {
+		d.warn(path, "%d elements do not fit in an array of %d", len(array), v.Len())
+		array = array[:v.Len()]
+	}
+
+	for i, value := range array {
+		d.decode(fmt.Sprintf("%s[%d]", path, i), value, v.Index(i))
+	}
+}
+
+func (d *tolerantDecoder) decodeInt(path string, data json.RawMessage, v reflect.Value) {
+	number, ok := d.number(path, data)
+	if !ok {
+		return
+	}
+
+	bits := uint(v.Type().Bits())
+	min := int64(-1) << (bits - 1)
+	max := int64(1)<<(bits-1) - 1
+
+	n, err := strconv.ParseInt(number, 10, 64)
+	if err == nil && n >= min && n <= max {
+		v.SetInt(n)
+		return
+	}
+
+	f, err := strconv.ParseFloat(number, 64)
+	if err != nil {
+		d.warn(path, "invalid number %s", number)
+		return
+	}
+
+	switch {
+	case f < float64(min):
+		d.warn(path, "%s overflows %s, clamped to %d", number, v.Type(), min)
+		v.SetInt(min)
+	case f >= -float64(min):
+		d.warn(path, "%s overflows %s, clamped to %d", number, v.Type(), max)
+		v.SetInt(max)
+	default:
+		d.warn(path, "%s is not an integer, truncated", number)
+		v.SetInt(int64(f))
+	}
+}
+
+func (d *tolerantDecoder) decodeUint(path string, data json.RawMessage, v reflect.Value) {
+	number, ok := d.number(path, data)
+	if !ok {
+		return
+	}
+
+	max := uint64(math.MaxUint64) >> (64 - uint(v.Type().Bits()))
+
+	n, err := strconv.ParseUint(number, 10, 64)
+	if err == nil && n <= max {
+		v.SetUint(n)
+		return
+	}
+
+	f, err := strconv.ParseFloat(number, 64)
+	if err != nil {
+		d.warn(path, "invalid number %s", number)
+		return
+	}
+
+	switch {
+	case f < 0:
+		d.warn(path, "%s overflows %s, clamped to 0", number, v.Type())
+		v.SetUint(0)
+	case f >= float64(max)+1:
+		d.warn(path, "%s overflows %s, clamped to %d", number, v.Type(), max)
+		v.SetUint(max)
+	default:
+		d.warn(path, "%s is not an integer, truncated", number)
+		v.SetUint(uint64(f))
+	}
+}
+
+func (d *tolerantDecoder) decodeFloat(path string, data json.RawMessage, v reflect.Value) {
+	number, ok := d.number(path, data)
+	if !ok {
+		return
+	}
+
+	f, err := strconv.ParseFloat(number, v.Type().Bits())
+	if err != nil {
+		d.warn(path, "%s overflows %s", number, v.Type())
+	}
+
+	v.SetFloat(f)
+}
+
+func (d *tolerantDecoder) decodeBool(path string, data json.RawMessage, v reflect.Value) {
+	switch string(data) {
+	case "true":
+		v.SetBool(true)
+	case "false":
+		v.SetBool(false)
+	case "0", "1":
+		d.warn(path, "expected a boolean, got %s", data)
+		v.SetBool(string(data) == "1")
+	default:
+		d.warn(path, "expected a boolean, got %s", describe(data))
+	}
+}
+
+func (d *tolerantDecoder) decodeString(path string, data json.RawMessage, v reflect.Value) {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		v.SetString(s)
+		return
+	}
+
+	// Numbers and booleans keep their literal text
+	if data[0] != '{' && data[0] != '[' {
+		d.warn(path, "expected a string, got %s", describe(data))
+		v.SetString(string(data))
+		return
+	}
+
+	d.warn(path, "expected a string, got %s", describe(data))
+}
+
+// number returns the literal of a JSON number, numbers sent as strings are accepted with a warning.
+func (d *tolerantDecoder) number(path string, data json.RawMessage) (string, bool) {
+	var number json.Number
+	if err := json.Unmarshal(data, &number); err != nil {
+		d.warn(path, "expected a number, got %s", describe(data))
+		return "", false
+	}
+
+	if data[0] == '"' {
+		if _, err := strconv.ParseFloat(number.String(), 64); err != nil {
+			d.warn(path, "expected a number, got %s", describe(data))
+			return "", false
+		}
+
+		d.warn(path, "expected a number, got a string")
+	}
+
+	return number.String(), true
+}
+
+func describe(data json.RawMessage) string {
+	switch data[0] {
+	case '{':
+		return "an object"
+	case '[':
+		return "an array"
+	case '"':
+		return "a string"
+	case 't', 'f':
+		return "a boolean"
+	default:
+		return "a number"
+	}
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
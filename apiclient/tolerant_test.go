@@ -0,0 +1,174 @@
+package apiclient
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Kinveil/Riot-API-Golang/apiclient/ratelimiter"
+	"github.com/Kinveil/Riot-API-Golang/constants/continent"
+	"github.com/stretchr/testify/assert"
+)
+
+const tolerantMatchJSON = `{
+	"metadata": {"matchId": "NA1_1", "newMetadataField": 1},
+	"info": {
+		"gameDuration": 1800,
+		"participants": [{
+			"participantId": 1,
+			"totalTimeCCDealt": 40000,
+			"kills": 40000,
+			"deaths": -40000,
+			"allInPings": 2.7,
+			"assists": "4",
+			"win": 1,
+			"championName": 32,
+			"challenges": {"killParticipation": 1, "abilityUses": "many"},
+			"newParticipantField": {"nested": true}
+		}]
+	}
+}`
+
+func TestDecodeTolerant(t *testing.T) {
+	var strict Match
+	assert.Error(t, json.Unmarshal([]byte(tolerantMatchJSON), &strict))
+
+	var match Match
+	warnings, err := DecodeTolerant([]byte(tolerantMatchJSON), &match)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, MatchID("NA1_1"), match.Metadata.MatchID)
+	assert.Equal(t, json.RawMessage("1"), match.Metadata.Extra["newMetadataField"])
+	assert.Equal(t, int32(1800), match.Info.GameDuration)
+
+	participant := match.Info.Participants[0]
+	assert.Equal(t, int32(40000), participant.TotalTimeCCDealt)
+	assert.Equal(t, int16(32767), participant.Kills)
+	assert.Equal(t, int16(-32768), participant.Deaths)
+	assert.Equal(t, int16(2), participant.AllInPings)
+	assert.Equal(t, int16(4), participant.Assists)
+	assert.True(t, participant.Win)
+	assert.Equal(t, "32", participant.ChampionName)
+	assert.Equal(t, float64(1), participant.Challenges.KillParticipation)
+	assert.Equal(t, int32(0), participant.Challenges.AbilityUses)
+	assert.JSONEq(t, `{"nested": true}`, string(participant.Extra["newParticipantField"]))
+
+	paths := make(map[string]bool)
+	for _, w := range warnings {
+		paths[w.Path] = true
+	}
+
+	assert.Equal(t, map[string]bool{
+		"info.participants[0].kills":                  true,
+		"info.participants[0].deaths":                 true,
+		"info.participants[0].allInPings":             true,
+		"info.participants[0].assists":                true,
+		"info.participants[0].win":                    true,
+		"info.participants[0].championName":           true,
+		"info.participants[0].challenges.abilityUses": true,
+	}, paths)
+
+	_, err = DecodeTolerant([]byte(`{"metadata":`), &match)
+	assert.Error(t, err)
+}
+
+func TestDecodeTolerantTimeline(t *testing.T) {
+	data := []byte(`{"info": {"frames": [{
+		"timestamp": 60000,
+		"participantFrames": {"2": {"minionsKilled": 40000, "level": 40000}, "1": {"level": "3"}},
+		"events": [
+			{"type": "CHAMPION_KILL", "timestamp": 1000, "killerId": 1, "bounty": 1e9},
+			{"type": "NEW_EVENT", "timestamp": 2000}
+		]
+	}]}}`)
+
+	var strict MatchTimeline
+	assert.Error(t, json.Unmarshal(data, &strict))
+
+	var timeline MatchTimeline
+	warnings, err := DecodeTolerant(data, &timeline)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	frame := timeline.Info.Frames[0]
+	assert.Equal(t, int32(60000), frame.Timestamp)
+	if assert.Len(t, frame.ParticipantFrames, 2) {
+		assert.Equal(t, int16(1), frame.ParticipantFrames[0].ParticipantID)
+		assert.Equal(t, int16(3), frame.ParticipantFrames[0].Level)
+		assert.Equal(t, int32(40000), frame.ParticipantFrames[1].MinionsKilled)
+		assert.Equal(t, int16(32767), frame.ParticipantFrames[1].Level)
+	}
+
+	if assert.Len(t, frame.Events, 2) {
+		kill := frame.Events[0].(*MatchTimelineEvent_ChampionKill)
+		assert.Equal(t, int16(1), kill.KillerID)
+		assert.Equal(t, int16(32767), kill.Bounty)
+		assert.IsType(t, &MatchTimelineEvent_Unknown{}, frame.Events[1])
+	}
+
+	paths := make(map[string]bool)
+	for _, w := range warnings {
+		paths[w.Path] = true
+	}
+
+	assert.Equal(t, map[string]bool{
+		"info.frames[0].participantFrames.1.level": true,
+		"info.frames[0].participantFrames.2.level": true,
+		"info.frames[0].events[0].bounty":          true,
+	}, paths)
+}
+
+func TestWithTolerantDecoding(t *testing.T) {
+	client := newTestClient(t, func(req *ratelimiter.APIRequest) (int, interface{}) {
+		return http.StatusOK, json.RawMessage(tolerantMatchJSON)
+	})
+
+	_, err := client.GetMatch(continent.AMERICAS, "NA1_1")
+	assert.Error(t, err)
+
+	var warnings []DecodeWarning
+	match, err := client.WithTolerantDecoding(&warnings).GetMatch(continent.AMERICAS, "NA1_1")
+	if assert.NoError(t, err) {
+		assert.Equal(t, int16(32767), match.Info.Participants[0].Kills)
+		assert.Len(t, warnings, 7)
+	}
+
+	// Derived clients share the warnings
+	warnings = nil
+	derived := client.WithTolerantDecoding(&warnings).WithPriority(1)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			derived.GetMatch(continent.AMERICAS, "NA1_1")
+		}()
+	}
+	wg.Wait()
+	assert.Len(t, warnings, 28)
+}
+
+func TestWithTolerantDecodingCache(t *testing.T) {
+	requests := 0
+	client := newTestClient(t, func(req *ratelimiter.APIRequest) (int, interface{}) {
+		requests++
+		return http.StatusOK, json.RawMessage(tolerantMatchJSON)
+	})
+	cached := client.WithCache(time.Minute)
+
+	// A clamped match cached by a tolerant client is not served to strict clients
+	_, err := cached.WithTolerantDecoding(nil).GetMatch(continent.AMERICAS, "NA1_1")
+	assert.NoError(t, err)
+	_, err = cached.GetMatch(continent.AMERICAS, "NA1_1")
+	assert.Error(t, err)
+
+	_, err = cached.WithTolerantDecoding(nil).GetMatch(continent.AMERICAS, "NA1_1")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, requests)
+}
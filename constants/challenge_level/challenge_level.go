@@ -0,0 +1,54 @@
+package challenge_level
+
+type Level string
+
+const (
+	None        Level = "NONE"
+	Iron        Level = "IRON"
+	Bronze      Level = "BRONZE"
+	Silver      Level = "SILVER"
+	Gold        Level = "GOLD"
+	Platinum    Level = "PLATINUM"
+	Diamond     Level = "DIAMOND"
+	Master      Level = "MASTER"
+	Grandmaster Level = "GRANDMASTER"
+	Challenger  Level = "CHALLENGER"
+
+	// Special values in Riot's level enum that are never a player's current level
+	Highest                   Level = "HIGHEST"
+	HighestNotLeaderboardOnly Level = "HIGHEST_NOT_LEADERBOARD_ONLY"
+	Lowest                    Level = "LOWEST"
+)
+
+func (l Level) String() string {
+	return string(l)
+}
+
+var levelToOrder = map[Level]int{
+	None:        0,
+	Iron:        1,
+	Bronze:      2,
+	Silver:      3,
+	Gold:        4,
+	Platinum:    5,
+	Diamond:     6,
+	Master:      7,
+	Grandmaster: 8,
+	Challenger:  9,
+}
+
+// Order returns the position of the level from NONE (0) to CHALLENGER (9).
+// Unknown levels return -1.
+func (l Level) Order() int {
+	order, ok := levelToOrder[l]
+	if !ok {
+		return -1
+	}
+
+	return order
+}
+
+// HasLeaderboard reports whether Riot keeps a ranked leaderboard for the level.
+func (l Level) HasLeaderboard() bool {
+	return l == Master || l == Grandmaster || l == Challenger
+}
@@ -0,0 +1,31 @@
+package game
+
+import (
+	"strings"
+)
+
+type Game string
+
+const (
+	LeagueOfLegends    Game = "lol"
+	LegendsOfRuneterra Game = "lor"
+	TeamfightTactics   Game = "tft"
+	Valorant           Game = "val"
+)
+
+func (g Game) String() string {
+	return string(g)
+}
+
+var stringToGame = map[string]Game{
+	"LOL": LeagueOfLegends,
+	"LOR": LegendsOfRuneterra,
+	"TFT": TeamfightTactics,
+	"VAL": Valorant,
+}
+
+func FromString(gm string) (Game, bool) {
+	gm = strings.ToUpper(gm)
+	game, ok := stringToGame[gm]
+	return game, ok
+}
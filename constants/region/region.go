@@ -56,6 +56,9 @@ const (
 	VN2 Region = "VN2"
 )
 
+// All lists every region.
+var All = []Region{BR1, EUN1, EUW1, JP1, KR, LA1, LA2, ME1, NA1, OC1, RU, SEA, TR1, TW2, VN2}
+
 func (r Region) String() string {
 	return string(r)
 }
@@ -0,0 +1,280 @@
+package ladder
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/Kinveil/Riot-API-Golang/apiclient"
+	"github.com/Kinveil/Riot-API-Golang/constants/league/rank"
+	"github.com/Kinveil/Riot-API-Golang/constants/league/tier"
+	"github.com/Kinveil/Riot-API-Golang/constants/queue_ranked"
+	"github.com/Kinveil/Riot-API-Golang/constants/region"
+)
+
+// Step is a tier and division of the ladder. Apex tiers have no division and are fetched in a single request.
+type Step struct {
+	Tier tier.String
+	Rank rank.String
+}
+
+func (s Step) apex() bool {
+	return s.Tier == tier.Challenger || s.Tier == tier.Grandmaster || s.Tier == tier.Master
+}
+
+// Steps lists the ladder from the highest to the lowest division, the order in which it is crawled.
+var Steps = func() []Step {
+	steps := []Step{{Tier: tier.Challenger}, {Tier: tier.Grandmaster}, {Tier: tier.Master}}
+	for _, t := range []tier.String{tier.Diamond, tier.Emerald, tier.Platinum, tier.Gold, tier.Silver, tier.Bronze, tier.Iron} {
+		for _, r := range []rank.String{rank.I, rank.II, rank.III, rank.IV} {
+			steps = append(steps, Step{Tier: t, Rank: r})
+		}
+	}
+
+	return steps
+}()
+
+type Batch struct {
+	Region  region.Region
+	Step    Step
+	Page    int // 0 for apex tiers
+	Entries []apiclient.LeagueEntry
+}
+
+// RegionCheckpoint is the progress of the crawl of a region.
+type RegionCheckpoint struct {
+	Step int             // Index in Steps of the next step to crawl
+	Page int             // Next page of the step
+	Done bool            // Whether the whole ladder was crawled
+	Seen map[string]bool // Players already emitted, keyed by PUUID
+}
+
+// Checkpoint is the progress of a crawl. It can be stored as JSON and passed to a new crawl to resume it.
+// It remembers every player emitted to avoid duplicates, so its size grows with the ladder.
+type Checkpoint struct {
+	Queue   queue_ranked.String
+	Regions map[region.Region]*RegionCheckpoint
+}
+
+type Options struct {
+	Regions []region.Region // Defaults to region.All
+
+	// Called with each page of new entries. Calls for a region are made in ladder order, but calls for
+	// different regions can be concurrent, so that a slow consumer of one region does not block the others.
+	// Returning an error stops the crawl of the batch's region, and the batch is crawled again on resume.
+	OnBatch func(batch Batch) error
+
+	// Called after each batch with the updated checkpoint, which must not be used after returning. Calls are never concurrent.
+	OnCheckpoint func(checkpoint *Checkpoint)
+
+	// Resumes a previous crawl of the same queue
+	Checkpoint *Checkpoint
+}
+
+// Crawl walks the whole ladder of the queue in every region, regions being crawled in parallel.
+// Players that move divisions during the crawl are only emitted the first time they are seen, and players
+// promoted into a division that was already crawled are missed until the next crawl.
+// The returned checkpoint is complete when no error is returned.
+func Crawl(client apiclient.Client, q queue_ranked.String, opts Options) (*Checkpoint, error) {
+	checkpoint := opts.Checkpoint
+	if checkpoint == nil {
+		checkpoint = &Checkpoint{Queue: q}
+	} else if checkpoint.Queue != q {
+		return checkpoint, fmt.Errorf("checkpoint is for queue %s, not %s", checkpoint.Queue, q)
+	}
+
+	if checkpoint.Regions == nil {
+		checkpoint.Regions = make(map[region.Region]*RegionCheckpoint)
+	}
+
+	regions := opts.Regions
+	if len(regions) == 0 {
+		regions = region.All
+	}
+
+	c := &crawler{
+		client:     client,
+		queue:      q,
+		opts:       opts,
+		checkpoint: checkpoint,
+	}
+
+	for _, r := range regions {
+		if _, ok := checkpoint.Regions[r]; !ok {
+			checkpoint.Regions[r] = &RegionCheckpoint{Page: 1}
+		}
+
+		if checkpoint.Regions[r].Seen == nil {
+			checkpoint.Regions[r].Seen = make(map[string]bool)
+		}
+	}
+
+	var wg sync.WaitGroup
+	errs := make(map[region.Region]error)
+	for _, r := range regions {
+		wg.Add(1)
+		go func(r region.Region) {
+			defer wg.Done()
+			if err := c.crawlRegion(r); err != nil {
+				c.mutex.Lock()
+				errs[r] = err
+				c.mutex.Unlock()
+			}
+		}(r)
+	}
+
+	wg.Wait()
+
+	if len(errs) > 0 {
+		messages := make([]string, 0, len(errs))
+		for r, err := range errs {
+			messages = append(messages, fmt.Sprintf("%s: %v", r, err))
+		}
+
+		sort.Strings(messages)
+		return checkpoint, fmt.Errorf("failed to crawl %d regions: %s", len(errs), strings.Join(messages, "; "))
+	}
+
+	return checkpoint, nil
+}
+
+type crawler struct {
+	client     apiclient.Client
+	queue      queue_ranked.String
+	opts       Options
+	checkpoint *Checkpoint
+	mutex      sync.Mutex // Guards the checkpoint and OnCheckpoint
+}
+
+func (c *crawler) crawlRegion(r region.Region) error {
+	for {
+		c.mutex.Lock()
+		progress := *c.checkpoint.Regions[r]
+		c.mutex.Unlock()
+
+		if progress.Done || progress.Step >= len(Steps) {
+			return nil
+		}
+
+		step := Steps[progress.Step]
+
+		var entries []apiclient.LeagueEntry
+		var err error
+		if step.apex() {
+			entries, err = c.apexEntries(r, step)
+		} else {
+			entries, err = c.client.GetLeagueEntries(r, c.queue, step.Tier, step.Rank, progress.Page)
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to get %s %s page %d: %w", step.Tier, step.Rank, progress.Page, err)
+		}
+
+		// Apex tiers are a single page, other divisions end with an empty page
+		next := progress
+		if step.apex() || len(entries) == 0 {
+			next.Step++
+			next.Page = 1
+		} else {
+			next.Page++
+		}
+		next.Done = next.Step >= len(Steps)
+
+		if err := c.emit(r, step, progress.Page, entries, next); err != nil {
+			return err
+		}
+	}
+}
+
+// emit sends the entries that were not seen yet and saves the progress of the region.
+// Only the goroutine of the region changes its progress, so it can be read without the lock.
+func (c *crawler) emit(r region.Region, step Step, page int, entries []apiclient.LeagueEntry, next RegionCheckpoint) error {
+	c.mutex.Lock()
+	progress := c.checkpoint.Regions[r]
+	c.mutex.Unlock()
+
+	var fresh []apiclient.LeagueEntry
+	for _, entry := range entries {
+		if !progress.Seen[playerKey(entry)] {
+			fresh = append(fresh, entry)
+		}
+	}
+
+	if len(fresh) > 0 && c.opts.OnBatch != nil {
+		if step.apex() {
+			page = 0
+		}
+
+		if err := c.opts.OnBatch(Batch{Region: r, Step: step, Page: page, Entries: fresh}); err != nil {
+			return err
+		}
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for _, entry := range fresh {
+		progress.Seen[playerKey(entry)] = true
+	}
+
+	progress.Step, progress.Page, progress.Done = next.Step, next.Page, next.Done
+
+	if c.opts.OnCheckpoint != nil {
+		c.opts.OnCheckpoint(c.checkpoint)
+	}
+
+	return nil
+}
+
+func (c *crawler) apexEntries(r region.Region, step Step) ([]apiclient.LeagueEntry, error) {
+	var list *apiclient.LeagueList
+	var err error
+	switch step.Tier {
+	case tier.Challenger:
+		list, err = c.client.GetLeagueEntriesChallenger(r, c.queue)
+	case tier.Grandmaster:
+		list, err = c.client.GetLeagueEntriesGrandmaster(r, c.queue)
+	default:
+		list, err = c.client.GetLeagueEntriesMaster(r, c.queue)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Sort by LP so the batch reads like a leaderboard
+	sort.SliceStable(list.Entries, func(i, j int) bool {
+		return list.Entries[i].LeaguePoints > list.Entries[j].LeaguePoints
+	})
+
+	entries := make([]apiclient.LeagueEntry, len(list.Entries))
+	for i, item := range list.Entries {
+		entries[i] = apiclient.LeagueEntry{
+			FreshBlood:   item.FreshBlood,
+			HotStreak:    item.HotStreak,
+			Inactive:     item.Inactive,
+			LeagueID:     list.LeagueID,
+			LeaguePoints: item.LeaguePoints,
+			Losses:       item.Losses,
+			QueueType:    list.Queue,
+			Rank:         item.Rank,
+			SummonerID:   item.SummonerID,
+			Puuid:        item.Puuid,
+			Tier:         list.Tier,
+			Veteran:      item.Veteran,
+			Wins:         item.Wins,
+		}
+	}
+
+	return entries, nil
+}
+
+// playerKey identifies the player of an entry, older entries only have a summoner ID.
+func playerKey(entry apiclient.LeagueEntry) string {
+	if entry.Puuid != "" {
+		return entry.Puuid
+	}
+
+	return entry.SummonerID
+}
@@ -0,0 +1,154 @@
+package ladder
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Kinveil/Riot-API-Golang/apiclient"
+	"github.com/Kinveil/Riot-API-Golang/constants/league/rank"
+	"github.com/Kinveil/Riot-API-Golang/constants/league/tier"
+	"github.com/Kinveil/Riot-API-Golang/constants/queue_ranked"
+	"github.com/Kinveil/Riot-API-Golang/constants/region"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeClient serves a ladder with one player per apex tier and two pages in Diamond I.
+type fakeClient struct {
+	apiclient.Client
+	mutex    sync.Mutex
+	requests int
+	failOn   int // Fails the nth request if not 0
+}
+
+func (c *fakeClient) request() error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.requests++
+	if c.requests == c.failOn {
+		return fmt.Errorf("server error")
+	}
+
+	return nil
+}
+
+func (c *fakeClient) apex(t tier.String, puuid string) (*apiclient.LeagueList, error) {
+	if err := c.request(); err != nil {
+		return nil, err
+	}
+
+	return &apiclient.LeagueList{Tier: t, Entries: []apiclient.LeagueItem{{Puuid: puuid, Rank: rank.I}}}, nil
+}
+
+func (c *fakeClient) GetLeagueEntriesChallenger(r region.Region, q queue_ranked.String) (*apiclient.LeagueList, error) {
+	return c.apex(tier.Challenger, "challenger")
+}
+
+func (c *fakeClient) GetLeagueEntriesGrandmaster(r region.Region, q queue_ranked.String) (*apiclient.LeagueList, error) {
+	return c.apex(tier.Grandmaster, "grandmaster")
+}
+
+func (c *fakeClient) GetLeagueEntriesMaster(r region.Region, q queue_ranked.String) (*apiclient.LeagueList, error) {
+	// The grandmaster player was demoted during the crawl
+	return c.apex(tier.Master, "grandmaster")
+}
+
+func (c *fakeClient) GetLeagueEntries(r region.Region, q queue_ranked.String, t tier.String, rk rank.String, page int) ([]apiclient.LeagueEntry, error) {
+	if err := c.request(); err != nil {
+		return nil, err
+	}
+
+	if t != tier.Diamond || rk != rank.I || page > 2 {
+		return nil, nil
+	}
+
+	return []apiclient.LeagueEntry{{Puuid: fmt.Sprintf("diamond-%d", page), Tier: t, Rank: rk}}, nil
+}
+
+func TestCrawl(t *testing.T) {
+	client := &fakeClient{}
+
+	var mutex sync.Mutex
+	var entries []string
+	checkpoint, err := Crawl(client, queue_ranked.RankedSolo5x5.String(), Options{
+		Regions: []region.Region{region.NA1, region.EUW1},
+		OnBatch: func(batch Batch) error {
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			for _, entry := range batch.Entries {
+				entries = append(entries, string(batch.Region)+" "+entry.Puuid)
+			}
+			return nil
+		},
+	})
+
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{
+		"NA1 challenger", "NA1 grandmaster", "NA1 diamond-1", "NA1 diamond-2",
+		"EUW1 challenger", "EUW1 grandmaster", "EUW1 diamond-1", "EUW1 diamond-2",
+	}, entries)
+	assert.True(t, checkpoint.Regions[region.NA1].Done)
+	assert.True(t, checkpoint.Regions[region.EUW1].Done)
+
+	// 3 apex requests, 3 pages of Diamond I and one empty page for the other 27 divisions
+	assert.Equal(t, 2*(3+3+27), client.requests)
+}
+
+func TestCrawlResume(t *testing.T) {
+	var entries []string
+	opts := Options{
+		Regions: []region.Region{region.KR},
+		OnBatch: func(batch Batch) error {
+			for _, entry := range batch.Entries {
+				entries = append(entries, entry.Puuid)
+			}
+			return nil
+		},
+	}
+
+	// Fail on the second page of Diamond I
+	checkpoint, err := Crawl(&fakeClient{failOn: 5}, queue_ranked.RankedSolo5x5.String(), opts)
+	assert.Error(t, err)
+	assert.Equal(t, []string{"challenger", "grandmaster", "diamond-1"}, entries)
+	assert.Equal(t, 3, checkpoint.Regions[region.KR].Step)
+	assert.Equal(t, 2, checkpoint.Regions[region.KR].Page)
+
+	opts.Checkpoint = checkpoint
+	client := &fakeClient{}
+	_, err = Crawl(client, queue_ranked.RankedSolo5x5.String(), opts)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"challenger", "grandmaster", "diamond-1", "diamond-2"}, entries)
+	assert.Equal(t, 2+27, client.requests)
+
+	_, err = Crawl(client, queue_ranked.RankedFlexSR.String(), opts)
+	assert.Error(t, err)
+}
+
+func TestCrawlSlowConsumer(t *testing.T) {
+	release := make(chan struct{})
+
+	// NA1 batches wait for EUW1 to be crawled, which needs EUW1 batches to run meanwhile
+	_, err := Crawl(&fakeClient{}, queue_ranked.RankedSolo5x5.String(), Options{
+		Regions: []region.Region{region.NA1, region.EUW1},
+		OnBatch: func(batch Batch) error {
+			if batch.Region == region.NA1 {
+				select {
+				case <-release:
+				case <-time.After(5 * time.Second):
+					return fmt.Errorf("EUW1 was blocked by NA1")
+				}
+			}
+
+			if batch.Region == region.EUW1 && batch.Entries[0].Puuid == "diamond-2" {
+				close(release)
+			}
+
+			return nil
+		},
+	})
+
+	assert.NoError(t, err)
+}
@@ -0,0 +1,33 @@
+package matches
+
+import (
+	"github.com/Kinveil/Riot-API-Golang/apiclient"
+	"github.com/Kinveil/Riot-API-Golang/constants/league/tier"
+	"github.com/Kinveil/Riot-API-Golang/constants/queue_ranked"
+)
+
+// PlayerFilter decides whether a player found in a match is added to the frontier.
+type PlayerFilter func(client apiclient.Client, player Player) (bool, error)
+
+// RankFilter keeps the players ranked in one of the tiers of the queue. It costs one request per player.
+func RankFilter(q queue_ranked.String, tiers ...tier.String) PlayerFilter {
+	allowed := make(map[tier.String]bool, len(tiers))
+	for _, t := range tiers {
+		allowed[t] = true
+	}
+
+	return func(client apiclient.Client, player Player) (bool, error) {
+		entries, err := client.GetLeagueEntriesByPuuid(player.Region, player.Puuid)
+		if err != nil {
+			return false, err
+		}
+
+		for _, entry := range entries {
+			if entry.QueueType == q {
+				return allowed[entry.Tier], nil
+			}
+		}
+
+		return false, nil
+	}
+}
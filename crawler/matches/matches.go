@@ -0,0 +1,426 @@
+package matches
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Kinveil/Riot-API-Golang/apiclient"
+	"github.com/Kinveil/Riot-API-Golang/constants/patch"
+	"github.com/Kinveil/Riot-API-Golang/constants/queue"
+	"github.com/Kinveil/Riot-API-Golang/constants/region"
+	"github.com/Kinveil/Riot-API-Golang/staticdata"
+)
+
+const (
+	defaultMatchesPerPlayer = 20
+	maxMatchesPerPlayer     = 100
+	defaultConcurrency      = 2
+	defaultMaxAttempts      = 3
+	// Players waiting for a worker of their region, per worker
+	pendingPerWorker = 4
+)
+
+type Config struct {
+	Matches   MatchSink    // Required
+	Timelines TimelineSink // Timelines are only fetched if set
+
+	Queue            *queue.ID
+	MatchesPerPlayer int // Number of recent matches fetched per player, defaults to 20 (maximum 100)
+	Concurrency      int // Number of players crawled at the same time per region, defaults to 2
+	MaxAttempts      int // Number of times a player is crawled before giving up on failed requests, defaults to 3
+
+	// Only matches played between these times are crawled, zero values are unbounded
+	StartTime time.Time
+	EndTime   time.Time
+
+	// Only matches played during these patches are crawled, empty values are unbounded.
+	// Both patches must be in Patches, and patch start times are shifted for each region.
+	Patches   staticdata.PatchesWithStartTime
+	FromPatch patch.ShortPatch
+	ToPatch   patch.ShortPatch
+
+	MatchFilter  func(match *apiclient.Match) bool // Matches rejected are not sent to the sinks and their players are not crawled
+	PlayerFilter PlayerFilter                      // Players rejected are not crawled
+
+	// Called with the errors of requests for a player or match. The player is crawled again later, up to MaxAttempts times.
+	// Errors of the store and sinks stop the crawl instead, and requests interrupted by stopping the crawl are not reported.
+	OnError func(player Player, err error)
+}
+
+type Crawler struct {
+	client apiclient.Client
+	store  Store
+	config Config
+}
+
+func New(client apiclient.Client, store Store, config Config) *Crawler {
+	if config.MatchesPerPlayer <= 0 {
+		config.MatchesPerPlayer = defaultMatchesPerPlayer
+	} else if config.MatchesPerPlayer > maxMatchesPerPlayer {
+		config.MatchesPerPlayer = maxMatchesPerPlayer
+	}
+
+	if config.Concurrency <= 0 {
+		config.Concurrency = defaultConcurrency
+	}
+
+	if config.MaxAttempts <= 0 {
+		config.MaxAttempts = defaultMaxAttempts
+	}
+
+	return &Crawler{client: client, store: store, config: config}
+}
+
+// Seed adds players to the frontier, players already seen are skipped.
+func (c *Crawler) Seed(players ...Player) error {
+	for _, player := range players {
+		if err := c.push(player); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *Crawler) push(player Player) error {
+	isNew, err := c.store.MarkPlayer(player.Puuid)
+	if err != nil || !isNew {
+		return err
+	}
+
+	return c.store.Push(player)
+}
+
+// outcome is how a player left the crawl.
+type outcome int
+
+const (
+	crawled     outcome = iota
+	failed              // A request failed, the player is crawled again later
+	interrupted         // The crawl stopped, the player is crawled again by the next run
+)
+
+// Run crawls until the frontier is empty, the context is canceled or the store or a sink fails.
+// Players that were being crawled when it stops are pushed back to the frontier, so that another run resumes them.
+func (c *Crawler) Run(ctx context.Context) error {
+	if c.config.Matches == nil {
+		return fmt.Errorf("a match sink is required")
+	}
+
+	if err := c.validatePatches(); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	client := c.client.WithContext(ctx)
+
+	var (
+		mutex    sync.Mutex
+		fatal    error
+		active   int
+		finished = make(chan struct{}, 1)
+		regions  = make(map[region.Region]chan struct{})
+		wg       sync.WaitGroup
+	)
+
+	fail := func(err error) {
+		mutex.Lock()
+		if fatal == nil {
+			fatal = err
+		}
+		mutex.Unlock()
+		cancel()
+	}
+
+	requeue := func(player Player) {
+		if err := c.store.Push(player); err != nil {
+			fail(fmt.Errorf("failed to push player %s: %w", player.Puuid, err))
+		}
+	}
+
+	wait := func() {
+		select {
+		case <-finished:
+		case <-ctx.Done():
+		}
+	}
+
+	for ctx.Err() == nil {
+		// Players pushed by the workers are in the store once they finish, so an empty frontier is only final
+		// if no worker was active before popping
+		mutex.Lock()
+		idle := active == 0
+		full := active >= len(regions)*c.config.Concurrency*pendingPerWorker+c.config.Concurrency
+		mutex.Unlock()
+
+		if full {
+			wait()
+			continue
+		}
+
+		player, ok, err := c.store.Pop()
+		if err != nil {
+			fail(fmt.Errorf("failed to pop player: %w", err))
+			break
+		}
+
+		if !ok {
+			if idle {
+				break
+			}
+
+			wait()
+			continue
+		}
+
+		semaphore, exists := regions[player.Region]
+		if !exists {
+			semaphore = make(chan struct{}, c.config.Concurrency)
+			regions[player.Region] = semaphore
+		}
+
+		mutex.Lock()
+		active++
+		mutex.Unlock()
+
+		wg.Add(1)
+		go func(player Player) {
+			defer wg.Done()
+			defer func() {
+				mutex.Lock()
+				active--
+				mutex.Unlock()
+
+				select {
+				case finished <- struct{}{}:
+				default:
+				}
+			}()
+
+			select {
+			case semaphore <- struct{}{}:
+			case <-ctx.Done():
+				requeue(player)
+				return
+			}
+			defer func() { <-semaphore }()
+
+			result, err := c.crawlPlayer(ctx, client, player)
+			if err != nil {
+				fail(err)
+			}
+
+			switch result {
+			case failed:
+				player.Attempts++
+				if player.Attempts < c.config.MaxAttempts {
+					requeue(player)
+				}
+			case interrupted:
+				requeue(player)
+			}
+		}(player)
+	}
+
+	wg.Wait()
+
+	if fatal != nil {
+		return fatal
+	}
+
+	return ctx.Err()
+}
+
+// validatePatches checks that the patch bounds are in the patches, which would otherwise leave the crawl unbounded.
+func (c *Crawler) validatePatches() error {
+	for _, bound := range []patch.ShortPatch{c.config.FromPatch, c.config.ToPatch} {
+		if bound == "" {
+			continue
+		}
+
+		found := false
+		for _, p := range c.config.Patches {
+			if p.Patch == bound {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return fmt.Errorf("patch %s is not in the patches of the config", bound)
+		}
+	}
+
+	return nil
+}
+
+// crawlPlayer sends the new matches of the player to the sinks and pushes the players found in them.
+// Only store and sink errors are returned.
+func (c *Crawler) crawlPlayer(ctx context.Context, client apiclient.Client, player Player) (outcome, error) {
+	opts := &apiclient.GetMatchlistOptions{Queue: c.config.Queue}
+
+	count := int16(c.config.MatchesPerPlayer)
+	opts.Count = &count
+
+	start, end := c.bounds(player.Region)
+	if !start.IsZero() {
+		opts.StartTime = &start
+	}
+	if !end.IsZero() {
+		opts.EndTime = &end
+	}
+
+	matchlist, err := client.GetMatchlist(player.Region.ContinentMatchV5(), player.Puuid, opts)
+	if err != nil {
+		return c.failure(ctx, player, err), nil
+	}
+
+	result := crawled
+	for _, matchID := range *matchlist {
+		isNew, err := c.store.MarkMatch(matchID)
+		if err != nil {
+			return interrupted, fmt.Errorf("failed to mark match %s: %w", matchID, err)
+		}
+
+		if !isNew {
+			continue
+		}
+
+		written, err := c.crawlMatch(ctx, client, player, matchID)
+		if !written {
+			// Forget the match so that it is crawled again with the player
+			if unmarkErr := c.store.UnmarkMatch(matchID); unmarkErr != nil && err == nil {
+				err = fmt.Errorf("failed to unmark match %s: %w", matchID, unmarkErr)
+			}
+		}
+
+		if err != nil {
+			return interrupted, err
+		}
+
+		if !written {
+			if ctx.Err() != nil {
+				return interrupted, nil
+			}
+
+			result = failed
+		}
+	}
+
+	return result, nil
+}
+
+// crawlMatch sends the match to the sinks and pushes its players. It reports whether the match is done,
+// which is the case once it was written or rejected by the match filter.
+func (c *Crawler) crawlMatch(ctx context.Context, client apiclient.Client, player Player, matchID apiclient.MatchID) (bool, error) {
+	match, err := client.GetMatchByID(matchID)
+	if err != nil {
+		c.failure(ctx, player, err)
+		return false, nil
+	}
+
+	if c.config.MatchFilter != nil && !c.config.MatchFilter(match) {
+		return true, nil
+	}
+
+	// The timeline is fetched before writing, so that a failed request does not write the match twice
+	var timeline *apiclient.MatchTimeline
+	if c.config.Timelines != nil {
+		timeline, err = client.GetMatchTimelineByID(matchID)
+		if err != nil {
+			c.failure(ctx, player, err)
+			return false, nil
+		}
+	}
+
+	if err := c.config.Matches.WriteMatch(match); err != nil {
+		return false, fmt.Errorf("failed to write match %s: %w", matchID, err)
+	}
+
+	if timeline != nil {
+		if err := c.config.Timelines.WriteTimeline(timeline); err != nil {
+			return true, fmt.Errorf("failed to write timeline %s: %w", matchID, err)
+		}
+	}
+
+	for _, participant := range match.Info.Participants {
+		found := Player{Puuid: participant.SummonerPuuid, Region: match.Info.PlatformID}
+		if found.Puuid == "" || found.Puuid == player.Puuid {
+			continue
+		}
+
+		isNew, err := c.store.MarkPlayer(found.Puuid)
+		if err != nil {
+			return true, fmt.Errorf("failed to mark player %s: %w", found.Puuid, err)
+		}
+
+		if !isNew {
+			continue
+		}
+
+		if c.config.PlayerFilter != nil {
+			ok, err := c.config.PlayerFilter(client, found)
+			if err != nil {
+				// Forget the player so that it can be found again in another match
+				if err := c.store.UnmarkPlayer(found.Puuid); err != nil {
+					return true, fmt.Errorf("failed to unmark player %s: %w", found.Puuid, err)
+				}
+
+				c.failure(ctx, found, err)
+				continue
+			}
+
+			if !ok {
+				continue
+			}
+		}
+
+		if err := c.store.Push(found); err != nil {
+			return true, fmt.Errorf("failed to push player %s: %w", found.Puuid, err)
+		}
+	}
+
+	return true, nil
+}
+
+// failure reports a failed request, unless it failed because the crawl stopped.
+func (c *Crawler) failure(ctx context.Context, player Player, err error) outcome {
+	if ctx.Err() != nil {
+		return interrupted
+	}
+
+	if c.config.OnError != nil {
+		c.config.OnError(player, err)
+	}
+
+	return failed
+}
+
+// bounds returns the time window of the crawl in the region, the intersection of the times and patches of the config.
+func (c *Crawler) bounds(r region.Region) (time.Time, time.Time) {
+	start, end := c.config.StartTime, c.config.EndTime
+
+	for i, p := range c.config.Patches {
+		if c.config.FromPatch != "" && p.Patch == c.config.FromPatch {
+			patchStart := p.GetRegionStartDate(r)
+			if start.IsZero() || patchStart.After(start) {
+				start = patchStart
+			}
+		}
+
+		// The patch ends when the next one starts
+		if c.config.ToPatch != "" && p.Patch == c.config.ToPatch && i+1 < len(c.config.Patches) {
+			next := c.config.Patches[i+1]
+			patchEnd := next.GetRegionStartDate(r)
+			if end.IsZero() || patchEnd.Before(end) {
+				end = patchEnd
+			}
+		}
+	}
+
+	return start, end
+}
@@ -0,0 +1,232 @@
+package matches
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Kinveil/Riot-API-Golang/apiclient"
+	"github.com/Kinveil/Riot-API-Golang/constants/continent"
+	"github.com/Kinveil/Riot-API-Golang/constants/patch"
+	"github.com/Kinveil/Riot-API-Golang/constants/region"
+	"github.com/Kinveil/Riot-API-Golang/staticdata"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeClient serves a chain of matches: player i played match i with player i+1.
+type fakeClient struct {
+	apiclient.Client
+	mutex   sync.Mutex
+	ctx     context.Context
+	players int
+
+	failures    map[apiclient.MatchID]int // Number of times fetching each match fails
+	interrupt   func()                    // Called when fetching interruptOn, which then fails as canceled
+	interruptOn apiclient.MatchID
+}
+
+func (c *fakeClient) WithContext(ctx context.Context) apiclient.Client {
+	c.ctx = ctx
+	return c
+}
+
+func (c *fakeClient) GetMatchlist(cont continent.Continent, puuid string, opts *apiclient.GetMatchlistOptions) (*apiclient.Matchlist, error) {
+	var i int
+	fmt.Sscanf(puuid, "p%d", &i)
+
+	matchlist := apiclient.Matchlist{apiclient.MatchID(fmt.Sprintf("EUW1_%d", i))}
+	if i > 0 {
+		matchlist = append(matchlist, apiclient.MatchID(fmt.Sprintf("EUW1_%d", i-1)))
+	}
+
+	return &matchlist, nil
+}
+
+func (c *fakeClient) GetMatchByID(matchID apiclient.MatchID) (*apiclient.Match, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if matchID == c.interruptOn && c.interrupt != nil {
+		c.interrupt()
+		c.interrupt = nil
+	}
+
+	// Like the real client, an empty match is returned along with errors
+	if err := c.ctx.Err(); err != nil {
+		return &apiclient.Match{}, err
+	}
+
+	if c.failures[matchID] > 0 {
+		c.failures[matchID]--
+		return &apiclient.Match{}, fmt.Errorf("server error")
+	}
+
+	var i int
+	fmt.Sscanf(string(matchID), "EUW1_%d", &i)
+
+	match := &apiclient.Match{}
+	match.Metadata.MatchID = matchID
+	match.Info.PlatformID = region.EUW1
+	for _, j := range []int{i, i + 1} {
+		if j < c.players {
+			match.Info.Participants = append(match.Info.Participants, apiclient.MatchInfoParticipant{SummonerPuuid: fmt.Sprintf("p%d", j)})
+		}
+	}
+
+	return match, nil
+}
+
+func TestRun(t *testing.T) {
+	client := &fakeClient{players: 10}
+
+	var mutex sync.Mutex
+	var found []string
+	sink := MatchSinkFunc(func(match *apiclient.Match) error {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		found = append(found, string(match.Metadata.MatchID))
+		return nil
+	})
+
+	crawler := New(client, NewMemoryStore(), Config{Matches: sink})
+	assert.NoError(t, crawler.Seed(Player{Puuid: "p0", Region: region.EUW1}))
+	assert.NoError(t, crawler.Run(context.Background()))
+
+	// Every match is written once, the last one only has a single player
+	assert.Len(t, found, 10)
+}
+
+func TestRunErrors(t *testing.T) {
+	var mutex sync.Mutex
+	var found []apiclient.MatchID
+	var skipped []error
+	config := Config{
+		Matches: MatchSinkFunc(func(match *apiclient.Match) error {
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			found = append(found, match.Metadata.MatchID)
+			return nil
+		}),
+		OnError: func(player Player, err error) {
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			skipped = append(skipped, err)
+		},
+	}
+
+	// The player of the failed match is crawled again
+	crawler := New(&fakeClient{players: 10, failures: map[apiclient.MatchID]int{"EUW1_4": 1}}, NewMemoryStore(), config)
+	assert.NoError(t, crawler.Seed(Player{Puuid: "p0", Region: region.EUW1}))
+	assert.NoError(t, crawler.Run(context.Background()))
+	assert.Len(t, skipped, 1)
+	assert.Len(t, found, 10)
+
+	// Until it failed too many times, the chain then stops at the failed match
+	found, skipped = nil, nil
+	crawler = New(&fakeClient{players: 10, failures: map[apiclient.MatchID]int{"EUW1_4": 100}}, NewMemoryStore(), config)
+	assert.NoError(t, crawler.Seed(Player{Puuid: "p0", Region: region.EUW1}))
+	assert.NoError(t, crawler.Run(context.Background()))
+	assert.Len(t, skipped, defaultMaxAttempts)
+	assert.Len(t, found, 4)
+
+	crawler = New(&fakeClient{players: 10}, NewMemoryStore(), Config{
+		Matches: MatchSinkFunc(func(match *apiclient.Match) error { return fmt.Errorf("disk full") }),
+	})
+	assert.NoError(t, crawler.Seed(Player{Puuid: "p0", Region: region.EUW1}))
+	assert.ErrorContains(t, crawler.Run(context.Background()), "disk full")
+}
+
+func TestRunResume(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	client := &fakeClient{players: 10, interrupt: cancel, interruptOn: "EUW1_4"}
+	store := NewMemoryStore()
+
+	var mutex sync.Mutex
+	found := make(map[apiclient.MatchID]int)
+	crawler := New(client, store, Config{
+		Matches: MatchSinkFunc(func(match *apiclient.Match) error {
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			found[match.Metadata.MatchID]++
+			return nil
+		}),
+		OnError: func(player Player, err error) {
+			t.Error(err)
+		},
+	})
+	assert.NoError(t, crawler.Seed(Player{Puuid: "p0", Region: region.EUW1}))
+
+	// The player whose match was interrupted is pushed back to the frontier
+	assert.ErrorIs(t, crawler.Run(ctx), context.Canceled)
+	assert.Len(t, found, 4)
+	assert.Len(t, store.frontier, 1)
+
+	assert.NoError(t, crawler.Run(context.Background()))
+	assert.Len(t, found, 10)
+	for matchID, writes := range found {
+		assert.Equal(t, 1, writes, matchID)
+	}
+}
+
+func TestRunConfig(t *testing.T) {
+	crawler := New(&fakeClient{}, NewMemoryStore(), Config{MatchesPerPlayer: 500})
+	assert.Equal(t, 100, crawler.config.MatchesPerPlayer)
+
+	crawler = New(&fakeClient{}, NewMemoryStore(), Config{
+		Matches:   MatchSinkFunc(func(match *apiclient.Match) error { return nil }),
+		Patches:   staticdata.PatchesWithStartTime{{Patch: "14.1"}},
+		FromPatch: "14.1",
+		ToPatch:   "14.2",
+	})
+	assert.ErrorContains(t, crawler.Run(context.Background()), "14.2")
+}
+
+func TestMemoryStore(t *testing.T) {
+	store := NewMemoryStore()
+
+	isNew, _ := store.MarkPlayer("a")
+	assert.True(t, isNew)
+	isNew, _ = store.MarkPlayer("a")
+	assert.False(t, isNew)
+
+	store.Push(Player{Puuid: "a"})
+	store.Push(Player{Puuid: "b"})
+
+	var popped []string
+	for {
+		player, ok, _ := store.Pop()
+		if !ok {
+			break
+		}
+		popped = append(popped, player.Puuid)
+	}
+	assert.Equal(t, []string{"a", "b"}, popped)
+}
+
+func TestBounds(t *testing.T) {
+	base := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+	crawler := New(nil, NewMemoryStore(), Config{
+		Patches: staticdata.PatchesWithStartTime{
+			{Patch: "14.1", StartTime: base},
+			{Patch: "14.2", StartTime: base.Add(14 * 24 * time.Hour)},
+			{Patch: "14.3", StartTime: base.Add(28 * 24 * time.Hour), Shifts: map[region.Region]int{region.EUW1: 3600}},
+		},
+		FromPatch: patch.ShortPatch("14.1"),
+		ToPatch:   patch.ShortPatch("14.2"),
+	})
+
+	start, end := crawler.bounds(region.EUW1)
+	assert.Equal(t, base, start)
+	assert.Equal(t, base.Add(28*24*time.Hour+time.Hour), end)
+
+	// An explicit time bound is kept when narrower than the patches
+	crawler.config.StartTime = base.Add(24 * time.Hour)
+	start, _ = crawler.bounds(region.NA1)
+	assert.Equal(t, base.Add(24*time.Hour), start)
+}
@@ -0,0 +1,27 @@
+package matches
+
+import "github.com/Kinveil/Riot-API-Golang/apiclient"
+
+// MatchSink receives the matches found by the crawler. Calls may be concurrent.
+type MatchSink interface {
+	WriteMatch(match *apiclient.Match) error
+}
+
+// TimelineSink receives the timelines of the matches found by the crawler. Calls may be concurrent.
+type TimelineSink interface {
+	WriteTimeline(timeline *apiclient.MatchTimeline) error
+}
+
+// MatchSinkFunc allows a function to be used as a MatchSink.
+type MatchSinkFunc func(match *apiclient.Match) error
+
+func (f MatchSinkFunc) WriteMatch(match *apiclient.Match) error {
+	return f(match)
+}
+
+// TimelineSinkFunc allows a function to be used as a TimelineSink.
+type TimelineSinkFunc func(timeline *apiclient.MatchTimeline) error
+
+func (f TimelineSinkFunc) WriteTimeline(timeline *apiclient.MatchTimeline) error {
+	return f(timeline)
+}
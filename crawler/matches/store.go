@@ -0,0 +1,110 @@
+package matches
+
+import (
+	"sync"
+
+	"github.com/Kinveil/Riot-API-Golang/apiclient"
+	"github.com/Kinveil/Riot-API-Golang/constants/region"
+)
+
+type Player struct {
+	Puuid    string
+	Region   region.Region
+	Attempts int // Failed attempts to crawl the player, set by the crawler
+}
+
+// Store holds the frontier of players to crawl and the players and matches already seen.
+// Implementations must be safe for concurrent use, for example to share the crawl between processes with a database.
+type Store interface {
+	// MarkPlayer records the player as seen and reports whether it was seen for the first time.
+	MarkPlayer(puuid string) (bool, error)
+
+	// MarkMatch records the match as seen and reports whether it was seen for the first time.
+	MarkMatch(matchID apiclient.MatchID) (bool, error)
+
+	// UnmarkPlayer and UnmarkMatch forget a player or match that could not be crawled, so that it is crawled again.
+	UnmarkPlayer(puuid string) error
+	UnmarkMatch(matchID apiclient.MatchID) error
+
+	// Push adds a player to the frontier.
+	Push(player Player) error
+
+	// Pop removes the next player from the frontier. It returns false if the frontier is empty.
+	Pop() (Player, bool, error)
+}
+
+// MemoryStore is a Store kept in memory, players are crawled in the order they were found.
+type MemoryStore struct {
+	mutex    sync.Mutex
+	players  map[string]bool
+	matches  map[apiclient.MatchID]bool
+	frontier []Player
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		players: make(map[string]bool),
+		matches: make(map[apiclient.MatchID]bool),
+	}
+}
+
+func (s *MemoryStore) MarkPlayer(puuid string) (bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.players[puuid] {
+		return false, nil
+	}
+
+	s.players[puuid] = true
+	return true, nil
+}
+
+func (s *MemoryStore) MarkMatch(matchID apiclient.MatchID) (bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.matches[matchID] {
+		return false, nil
+	}
+
+	s.matches[matchID] = true
+	return true, nil
+}
+
+func (s *MemoryStore) UnmarkPlayer(puuid string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delete(s.players, puuid)
+	return nil
+}
+
+func (s *MemoryStore) UnmarkMatch(matchID apiclient.MatchID) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delete(s.matches, matchID)
+	return nil
+}
+
+func (s *MemoryStore) Push(player Player) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.frontier = append(s.frontier, player)
+	return nil
+}
+
+func (s *MemoryStore) Pop() (Player, bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if len(s.frontier) == 0 {
+		return Player{}, false, nil
+	}
+
+	player := s.frontier[0]
+	s.frontier = s.frontier[1:]
+	return player, true, nil
+}
@@ -0,0 +1,77 @@
+package staticdata
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Kinveil/Riot-API-Golang/constants/language"
+	"github.com/Kinveil/Riot-API-Golang/constants/patch"
+)
+
+type ArenaAugmentRarity int
+
+const (
+	ArenaAugmentRaritySilver    ArenaAugmentRarity = 0
+	ArenaAugmentRarityGold      ArenaAugmentRarity = 1
+	ArenaAugmentRarityPrismatic ArenaAugmentRarity = 2
+)
+
+type ArenaAugments []ArenaAugment
+
+type ArenaAugment struct {
+	ID          int32              `json:"id"`
+	APIName     string             `json:"apiName"`
+	Name        string             `json:"name"`
+	Description string             `json:"desc"`
+	Tooltip     string             `json:"tooltip"`
+	Rarity      ArenaAugmentRarity `json:"rarity"`
+	IconLarge   string             `json:"iconLarge"` // ex: assets/ux/cherry/augments/icons/firebrand_large.png
+	IconSmall   string             `json:"iconSmall"`
+	DataValues  map[string]float64 `json:"dataValues"`
+
+	// Full URLs of the icons, set by GetArenaAugments
+	IconLargeURL string `json:"iconLargeUrl"`
+	IconSmallURL string `json:"iconSmallUrl"`
+}
+
+// GetArenaAugments returns the Arena augments from CommunityDragon.
+// An empty patch returns the augments of the latest patch.
+func GetArenaAugments(v patch.Patch, lang language.Language) (ArenaAugments, error) {
+	type Response struct {
+		Augments ArenaAugments `json:"augments"`
+	}
+
+	version := "latest"
+	if v != "" {
+		version = v.ShortPatch().String()
+	}
+
+	var res Response
+	err := getJSON(fmt.Sprintf("https://raw.communitydragon.org/%s/cdragon/arena/%s.json", version, strings.ToLower(string(lang))), &res)
+
+	for i := range res.Augments {
+		res.Augments[i].IconLargeURL = communityDragonAssetURL(version, res.Augments[i].IconLarge)
+		res.Augments[i].IconSmallURL = communityDragonAssetURL(version, res.Augments[i].IconSmall)
+	}
+
+	return res.Augments, err
+}
+
+func (augments ArenaAugments) Augment(augmentId int) (ArenaAugment, error) {
+	for _, a := range augments {
+		if a.ID == int32(augmentId) {
+			return a, nil
+		}
+	}
+
+	return ArenaAugment{}, fmt.Errorf("arena augment %d not found", augmentId)
+}
+
+// communityDragonAssetURL returns the URL of a game asset, CommunityDragon serves them with lowercase paths.
+func communityDragonAssetURL(version string, path string) string {
+	if path == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("https://raw.communitydragon.org/%s/game/%s", version, strings.ToLower(strings.TrimPrefix(path, "/")))
+}
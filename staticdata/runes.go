@@ -0,0 +1,158 @@
+package staticdata
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Kinveil/Riot-API-Golang/constants/language"
+	"github.com/Kinveil/Riot-API-Golang/constants/patch"
+)
+
+// RuneTrees are the rune trees, and the stat shards which are not part of any tree.
+type RuneTrees struct {
+	Trees  []RuneTree
+	Shards []StatShard
+}
+
+type RuneTree struct {
+	ID      int16      `json:"id"`
+	Key     string     `json:"key"`
+	Icon    string     `json:"icon"`
+	Name    string     `json:"name"`
+	Slots   []RuneSlot `json:"slots"`
+	IconURL string     `json:"iconUrl"` // Set by GetRuneTrees
+}
+
+type RuneSlot struct {
+	Runes []Rune `json:"runes"`
+}
+
+type Rune struct {
+	ID        int16  `json:"id"`
+	Key       string `json:"key"`
+	Icon      string `json:"icon"`
+	Name      string `json:"name"`
+	ShortDesc string `json:"shortDesc"`
+	LongDesc  string `json:"longDesc"`
+	IconURL   string `json:"iconUrl"` // Set by GetRuneTrees
+
+	// Labels of the Var1..3 values of match perk selections, ex: "Total Damage: @eogvar1@"
+	EndOfGameStatDescs []string `json:"endOfGameStatDescs"`
+}
+
+// StatShard is a stat shard, ex: Adaptive Force.
+type StatShard struct {
+	ID        int16
+	Name      string
+	ShortDesc string
+	IconURL   string
+}
+
+const runeIconBaseURL = "https://ddragon.leagueoflegends.com/cdn/img/"
+
+// GetRuneTrees returns the rune trees from DataDragon, with the end of game stat labels and the stat shards
+// from CommunityDragon, which DataDragon does not publish.
+func GetRuneTrees(v patch.Patch, lang language.Language) (*RuneTrees, error) {
+	var trees []RuneTree
+	if err := getJSON(fmt.Sprintf("http://ddragon.leagueoflegends.com/cdn/%s/data/%s/runesReforged.json", v, lang), &trees); err != nil {
+		return nil, err
+	}
+
+	type Perk struct {
+		ID                 int16    `json:"id"`
+		Name               string   `json:"name"`
+		ShortDesc          string   `json:"shortDesc"`
+		IconPath           string   `json:"iconPath"`
+		EndOfGameStatDescs []string `json:"endOfGameStatDescs"`
+	}
+
+	// CommunityDragon names the English locale "default"
+	locale := "default"
+	if lang != language.EnglishUnitedStates {
+		locale = strings.ToLower(string(lang))
+	}
+
+	version := v.ShortPatch().String()
+
+	var perks []Perk
+	err := getJSON(fmt.Sprintf("https://raw.communitydragon.org/%s/plugins/rcp-be-lol-game-data/global/%s/v1/perks.json", version, locale), &perks)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get perks: %w", err)
+	}
+
+	runes := &RuneTrees{Trees: trees}
+
+	statDescs := make(map[int16][]string, len(perks))
+	for _, perk := range perks {
+		statDescs[perk.ID] = perk.EndOfGameStatDescs
+
+		// Stat shards use the 5000 range of perk IDs
+		if perk.ID >= 5000 && perk.ID < 6000 {
+			runes.Shards = append(runes.Shards, StatShard{
+				ID:        perk.ID,
+				Name:      perk.Name,
+				ShortDesc: perk.ShortDesc,
+				IconURL:   communityDragonGameDataURL(version, perk.IconPath),
+			})
+		}
+	}
+
+	for i := range trees {
+		trees[i].IconURL = runeIconBaseURL + trees[i].Icon
+		for j := range trees[i].Slots {
+			for k := range trees[i].Slots[j].Runes {
+				r := &trees[i].Slots[j].Runes[k]
+				r.IconURL = runeIconBaseURL + r.Icon
+				r.EndOfGameStatDescs = statDescs[r.ID]
+			}
+		}
+	}
+
+	return runes, nil
+}
+
+// communityDragonGameDataURL returns the URL of a game data asset, ex: /lol-game-data/assets/v1/perk-images/...
+// CommunityDragon serves them from the game data plugin, with lowercase paths.
+func communityDragonGameDataURL(version string, path string) string {
+	if path == "" {
+		return ""
+	}
+
+	path = strings.TrimPrefix(strings.ToLower(path), "/lol-game-data/assets/")
+	return fmt.Sprintf("https://raw.communitydragon.org/%s/plugins/rcp-be-lol-game-data/global/default/%s", version, path)
+}
+
+func (runes *RuneTrees) Tree(treeId int) (RuneTree, error) {
+	for _, t := range runes.Trees {
+		if t.ID == int16(treeId) {
+			return t, nil
+		}
+	}
+
+	return RuneTree{}, fmt.Errorf("rune tree %d not found", treeId)
+}
+
+// Rune returns the rune and the tree it belongs to.
+func (runes *RuneTrees) Rune(runeId int) (Rune, RuneTree, error) {
+	for _, t := range runes.Trees {
+		for _, slot := range t.Slots {
+			for _, r := range slot.Runes {
+				if r.ID == int16(runeId) {
+					return r, t, nil
+				}
+			}
+		}
+	}
+
+	return Rune{}, RuneTree{}, fmt.Errorf("rune %d not found", runeId)
+}
+
+func (runes *RuneTrees) Shard(shardId int) (StatShard, error) {
+	for _, shard := range runes.Shards {
+		if shard.ID == int16(shardId) {
+			return shard, nil
+		}
+	}
+
+	return StatShard{}, fmt.Errorf("stat shard %d not found", shardId)
+}
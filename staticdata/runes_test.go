@@ -0,0 +1,66 @@
+package staticdata
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Kinveil/Riot-API-Golang/constants/language"
+	"github.com/stretchr/testify/assert"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestGetRuneTrees(t *testing.T) {
+	responses := map[string]string{
+		"http://ddragon.leagueoflegends.com/cdn/14.1.1/data/fr_FR/runesReforged.json": `[{
+			"id": 8100, "key": "Domination", "icon": "perk-images/Styles/7200_Domination.png", "name": "Domination",
+			"slots": [{"runes": [{"id": 8112, "key": "Electrocute", "icon": "perk-images/Styles/Domination/Electrocute/Electrocute.png", "name": "Électrocution"}]}]
+		}]`,
+		"https://raw.communitydragon.org/14.1/plugins/rcp-be-lol-game-data/global/fr_fr/v1/perks.json": `[
+			{"id": 8112, "name": "Électrocution", "iconPath": "/lol-game-data/assets/v1/perk-images/Styles/Domination/Electrocute/Electrocute.png", "endOfGameStatDescs": ["Dégâts totaux : @eogvar1@"]},
+			{"id": 5008, "name": "Force adaptative", "shortDesc": "+9 force adaptative", "iconPath": "/lol-game-data/assets/v1/perk-images/StatMods/StatModsAdaptiveForceIcon.png"}
+		]`,
+	}
+
+	transport := http.DefaultClient.Transport
+	defer func() { http.DefaultClient.Transport = transport }()
+
+	http.DefaultClient.Transport = roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		body, ok := responses[req.URL.String()]
+		if !ok {
+			return &http.Response{StatusCode: http.StatusNotFound, Body: io.NopCloser(strings.NewReader("")), Request: req}, nil
+		}
+
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body)), Request: req}, nil
+	})
+
+	runes, err := GetRuneTrees("14.1.1", language.FrenchFrance)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, []StatShard{{
+		ID:        5008,
+		Name:      "Force adaptative",
+		ShortDesc: "+9 force adaptative",
+		IconURL:   "https://raw.communitydragon.org/14.1/plugins/rcp-be-lol-game-data/global/default/v1/perk-images/statmods/statmodsadaptiveforceicon.png",
+	}}, runes.Shards)
+
+	r, _, err := runes.Rune(8112)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "https://ddragon.leagueoflegends.com/cdn/img/perk-images/Styles/Domination/Electrocute/Electrocute.png", r.IconURL)
+		assert.Equal(t, []string{"Dégâts totaux : @eogvar1@"}, r.EndOfGameStatDescs)
+	}
+
+	// Without the CommunityDragon perks there is no partial result
+	delete(responses, "https://raw.communitydragon.org/14.1/plugins/rcp-be-lol-game-data/global/fr_fr/v1/perks.json")
+	runes, err = GetRuneTrees("14.1.1", language.FrenchFrance)
+	assert.Error(t, err)
+	assert.Nil(t, runes)
+}
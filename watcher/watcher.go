@@ -0,0 +1,486 @@
+package watcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Kinveil/Riot-API-Golang/apiclient"
+	"github.com/Kinveil/Riot-API-Golang/constants/region"
+)
+
+type EventType string
+
+const (
+	GameStarted    EventType = "GAME_STARTED"
+	GameEnded      EventType = "GAME_ENDED"
+	MatchAvailable EventType = "MATCH_AVAILABLE"
+	MatchMissing   EventType = "MATCH_MISSING" // The match was not published before the timeout, as with custom games
+)
+
+type Event struct {
+	Type    EventType
+	Puuid   string
+	Region  region.Region
+	MatchID apiclient.MatchID
+	Game    *apiclient.ActiveGame // Last state of the game seen by the spectator API
+	Match   *apiclient.Match      // Only set for MatchAvailable
+}
+
+type Options struct {
+	OnEvent func(event Event) // Calls are never concurrent
+	OnError func(err error)   // Called with failed requests other than 404, which are retried at the next poll
+
+	Priority    *int // Priority of the requests, defaults to -1 so that regular requests are served first
+	Concurrency int  // Maximum number of requests in flight, defaults to 10
+
+	IdleInterval  time.Duration // Between polls of a player that is not in game, defaults to 2 minutes
+	EarlyInterval time.Duration // Between polls of a game that cannot be surrendered yet, defaults to 3 minutes
+	LateInterval  time.Duration // Between polls of a game that can end at any time, defaults to 30 seconds
+	SurrenderTime time.Duration // Game time before which games rarely end, defaults to 15 minutes
+
+	// Delay before fetching the match once the game ended, doubled after each miss up to MaxMatchInterval
+	MatchInterval    time.Duration // Defaults to 1 minute
+	MaxMatchInterval time.Duration // Defaults to 10 minutes
+	MatchTimeout     time.Duration // Defaults to 1 hour
+}
+
+const (
+	defaultPriority         = -1
+	defaultConcurrency      = 10
+	defaultIdleInterval     = 2 * time.Minute
+	defaultEarlyInterval    = 3 * time.Minute
+	defaultLateInterval     = 30 * time.Second
+	defaultSurrenderTime    = 15 * time.Minute
+	defaultMatchInterval    = time.Minute
+	defaultMaxMatchInterval = 10 * time.Minute
+	defaultMatchTimeout     = time.Hour
+	// Longest sleep of the loop when nothing is scheduled
+	maxWait = time.Minute
+)
+
+type subscription struct {
+	puuid  string
+	region region.Region
+	game   *liveGame // nil when not in game
+	next   time.Time
+	busy   bool
+}
+
+// liveGame is a game of subscribed players, polled once for all of them.
+type liveGame struct {
+	id     apiclient.MatchID
+	game   *apiclient.ActiveGame
+	puuids []string
+	next   time.Time
+	busy   bool
+}
+
+// pendingMatch is a game that ended and is waiting to be published by Match-v5.
+type pendingMatch struct {
+	id       apiclient.MatchID
+	game     *apiclient.ActiveGame
+	puuids   []string
+	next     time.Time
+	delay    time.Duration
+	deadline time.Time
+	busy     bool
+}
+
+// result is the outcome of a request, applied to the state by the loop.
+type result struct {
+	apply func(now time.Time) []Event
+	err   error
+}
+
+// Watcher polls the spectator API for a set of players and follows their games until Match-v5 publishes them.
+// Players in the same game share its polls, and games are polled less often before they can be surrendered.
+type Watcher struct {
+	client apiclient.Client
+	opts   Options
+
+	mutex         sync.Mutex
+	subscriptions map[string]*subscription
+	games         map[apiclient.MatchID]*liveGame
+	matches       map[apiclient.MatchID]*pendingMatch
+	wake          chan struct{}
+}
+
+func New(client apiclient.Client, opts Options) *Watcher {
+	if opts.Priority == nil {
+		priority := defaultPriority
+		opts.Priority = &priority
+	}
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = defaultConcurrency
+	}
+	if opts.IdleInterval <= 0 {
+		opts.IdleInterval = defaultIdleInterval
+	}
+	if opts.EarlyInterval <= 0 {
+		opts.EarlyInterval = defaultEarlyInterval
+	}
+	if opts.LateInterval <= 0 {
+		opts.LateInterval = defaultLateInterval
+	}
+	if opts.SurrenderTime <= 0 {
+		opts.SurrenderTime = defaultSurrenderTime
+	}
+	if opts.MatchInterval <= 0 {
+		opts.MatchInterval = defaultMatchInterval
+	}
+	if opts.MaxMatchInterval <= 0 {
+		opts.MaxMatchInterval = defaultMaxMatchInterval
+	}
+	if opts.MatchTimeout <= 0 {
+		opts.MatchTimeout = defaultMatchTimeout
+	}
+
+	return &Watcher{
+		client:        client,
+		opts:          opts,
+		subscriptions: make(map[string]*subscription),
+		games:         make(map[apiclient.MatchID]*liveGame),
+		matches:       make(map[apiclient.MatchID]*pendingMatch),
+		wake:          make(chan struct{}, 1),
+	}
+}
+
+// Add subscribes to the players of the region, they are polled right away. It can be called while running.
+func (w *Watcher) Add(r region.Region, puuids ...string) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	now := time.Now()
+	for _, puuid := range puuids {
+		if _, ok := w.subscriptions[puuid]; !ok {
+			w.subscriptions[puuid] = &subscription{puuid: puuid, region: r, next: now}
+		}
+	}
+
+	select {
+	case w.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Remove unsubscribes from the players, no more events are sent for them. It can be called while running.
+func (w *Watcher) Remove(puuids ...string) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	for _, puuid := range puuids {
+		sub, ok := w.subscriptions[puuid]
+		if !ok {
+			continue
+		}
+		delete(w.subscriptions, puuid)
+
+		if sub.game != nil {
+			sub.game.puuids = without(sub.game.puuids, puuid)
+			if len(sub.game.puuids) == 0 {
+				delete(w.games, sub.game.id)
+			}
+		}
+
+		for id, pending := range w.matches {
+			pending.puuids = without(pending.puuids, puuid)
+			if len(pending.puuids) == 0 {
+				delete(w.matches, id)
+			}
+		}
+	}
+}
+
+// Run polls until the context is canceled, it must not be called concurrently.
+func (w *Watcher) Run(ctx context.Context) error {
+	client := w.client.WithContext(ctx).WithPriority(*w.opts.Priority)
+	results := make(chan result)
+	inflight := 0
+
+	for {
+		w.mutex.Lock()
+		tasks, wait := w.due(time.Now(), w.opts.Concurrency-inflight)
+		w.mutex.Unlock()
+
+		for _, task := range tasks {
+			inflight++
+			go func(task func(client apiclient.Client) result) {
+				results <- task(client)
+			}(task)
+		}
+
+		select {
+		case <-ctx.Done():
+			for ; inflight > 0; inflight-- {
+				<-results
+			}
+			return ctx.Err()
+
+		case res := <-results:
+			inflight--
+
+			w.mutex.Lock()
+			events := res.apply(time.Now())
+			w.mutex.Unlock()
+
+			if res.err != nil && w.opts.OnError != nil {
+				w.opts.OnError(res.err)
+			}
+
+			if w.opts.OnEvent != nil {
+				for _, event := range events {
+					w.opts.OnEvent(event)
+				}
+			}
+
+		case <-time.After(wait):
+		case <-w.wake:
+		}
+	}
+}
+
+// due returns up to limit requests that are scheduled before now, and how long to wait for the next one.
+func (w *Watcher) due(now time.Time, limit int) ([]func(client apiclient.Client) result, time.Duration) {
+	var tasks []func(client apiclient.Client) result
+	wait := maxWait
+
+	schedule := func(next time.Time, busy *bool, task func(client apiclient.Client) result) {
+		if *busy {
+			return
+		}
+
+		if next.After(now) {
+			if next.Sub(now) < wait {
+				wait = next.Sub(now)
+			}
+			return
+		}
+
+		if len(tasks) < limit {
+			*busy = true
+			tasks = append(tasks, task)
+		}
+	}
+
+	for _, sub := range w.subscriptions {
+		if sub.game == nil {
+			sub := sub
+			schedule(sub.next, &sub.busy, func(client apiclient.Client) result { return w.pollPlayer(client, sub) })
+		}
+	}
+
+	for _, game := range w.games {
+		// Any player of the game can be polled, the first one is used
+		game, sub := game, w.subscriptions[game.puuids[0]]
+		schedule(game.next, &game.busy, func(client apiclient.Client) result { return w.pollGame(client, game, sub.region, sub.puuid) })
+	}
+
+	for _, pending := range w.matches {
+		pending := pending
+		schedule(pending.next, &pending.busy, func(client apiclient.Client) result { return w.fetchMatch(client, pending) })
+	}
+
+	return tasks, wait
+}
+
+// activeGame returns the game of the player, or nil if not in game.
+func (w *Watcher) activeGame(client apiclient.Client, r region.Region, puuid string) (*apiclient.ActiveGame, error) {
+	game, err := client.GetSpectatorActiveGameByPuuid(r, puuid)
+	if errors.Is(err, apiclient.ErrNotFound) {
+		return nil, nil
+	}
+
+	if err != nil {
+		// The client returns an empty game along with errors
+		return nil, fmt.Errorf("failed to get the active game of %s: %w", puuid, err)
+	}
+
+	return game, nil
+}
+
+func (w *Watcher) pollPlayer(client apiclient.Client, sub *subscription) result {
+	game, err := w.activeGame(client, sub.region, sub.puuid)
+
+	return result{err: err, apply: func(now time.Time) []Event {
+		sub.busy = false
+		sub.next = now.Add(w.opts.IdleInterval)
+
+		if w.subscriptions[sub.puuid] != sub || game == nil {
+			return nil
+		}
+
+		id := apiclient.NewMatchID(game.PlatformID, game.GameID)
+		if _, ended := w.matches[id]; ended {
+			// The spectator API can still return a game for a short while after it ended
+			return nil
+		}
+
+		return w.join(now, id, game, sub)
+	}}
+}
+
+// join adds the player and the other subscribed players of the game to it.
+// The game is only tracked if a player joins, the player can already be in another game when the result is stale.
+func (w *Watcher) join(now time.Time, id apiclient.MatchID, game *apiclient.ActiveGame, sub *subscription) []Event {
+	current, ok := w.games[id]
+	if !ok {
+		current = &liveGame{id: id, game: game, next: w.nextGamePoll(now, game)}
+	}
+
+	players := []*subscription{sub}
+	for _, participant := range game.Participants {
+		if participant.Puuid == nil || *participant.Puuid == sub.puuid {
+			continue
+		}
+
+		if other, ok := w.subscriptions[*participant.Puuid]; ok {
+			players = append(players, other)
+		}
+	}
+
+	var events []Event
+	for _, player := range players {
+		if player.game != nil {
+			continue
+		}
+
+		player.game = current
+		current.puuids = append(current.puuids, player.puuid)
+		w.games[id] = current
+		events = append(events, Event{Type: GameStarted, Puuid: player.puuid, Region: player.region, MatchID: id, Game: game})
+	}
+
+	return events
+}
+
+func (w *Watcher) pollGame(client apiclient.Client, current *liveGame, r region.Region, puuid string) result {
+	game, err := w.activeGame(client, r, puuid)
+	if err != nil {
+		return result{
+			err: err,
+			apply: func(now time.Time) []Event {
+				current.busy = false
+				current.next = now.Add(w.opts.LateInterval)
+				return nil
+			},
+		}
+	}
+
+	return result{apply: func(now time.Time) []Event {
+		current.busy = false
+
+		if w.games[current.id] != current {
+			return nil
+		}
+
+		if game != nil && apiclient.NewMatchID(game.PlatformID, game.GameID) == current.id {
+			current.game = game
+			current.next = w.nextGamePoll(now, game)
+			return nil
+		}
+
+		delete(w.games, current.id)
+		w.matches[current.id] = &pendingMatch{
+			id:       current.id,
+			game:     current.game,
+			puuids:   current.puuids,
+			next:     now.Add(w.opts.MatchInterval),
+			delay:    w.opts.MatchInterval,
+			deadline: now.Add(w.opts.MatchTimeout),
+		}
+
+		var events []Event
+		for _, p := range current.puuids {
+			player := w.subscriptions[p]
+			player.game = nil
+			player.next = now.Add(w.opts.IdleInterval)
+			events = append(events, Event{Type: GameEnded, Puuid: p, Region: player.region, MatchID: current.id, Game: current.game})
+		}
+
+		// The polled player is already in a new game
+		if game != nil {
+			if player, ok := w.subscriptions[puuid]; ok {
+				events = append(events, w.join(now, apiclient.NewMatchID(game.PlatformID, game.GameID), game, player)...)
+			}
+		}
+
+		return events
+	}}
+}
+
+// nextGamePoll polls slowly until the game can be surrendered, remakes being the exception.
+func (w *Watcher) nextGamePoll(now time.Time, game *apiclient.ActiveGame) time.Time {
+	var elapsed time.Duration
+	if game.GameStartTime > 0 {
+		elapsed = now.Sub(time.UnixMilli(game.GameStartTime))
+	}
+
+	delay := w.opts.LateInterval
+	if remaining := w.opts.SurrenderTime - elapsed; remaining > delay {
+		delay = w.opts.EarlyInterval
+		if remaining < delay {
+			delay = remaining
+		}
+	}
+
+	return now.Add(delay)
+}
+
+func (w *Watcher) fetchMatch(client apiclient.Client, pending *pendingMatch) result {
+	match, err := client.GetMatchByID(pending.id)
+	if err != nil {
+		// The client returns an empty match along with errors
+		match = nil
+
+		if errors.Is(err, apiclient.ErrNotFound) {
+			err = nil
+		} else {
+			err = fmt.Errorf("failed to get match %s: %w", pending.id, err)
+		}
+	}
+
+	return result{err: err, apply: func(now time.Time) []Event {
+		pending.busy = false
+
+		if w.matches[pending.id] != pending {
+			return nil
+		}
+
+		eventType := MatchAvailable
+		if match == nil {
+			if now.Before(pending.deadline) {
+				pending.delay *= 2
+				if pending.delay > w.opts.MaxMatchInterval {
+					pending.delay = w.opts.MaxMatchInterval
+				}
+				pending.next = now.Add(pending.delay)
+				return nil
+			}
+
+			eventType = MatchMissing
+		}
+
+		delete(w.matches, pending.id)
+
+		var events []Event
+		for _, p := range pending.puuids {
+			events = append(events, Event{Type: eventType, Puuid: p, Region: w.subscriptions[p].region, MatchID: pending.id, Game: pending.game, Match: match})
+		}
+
+		return events
+	}}
+}
+
+func without(puuids []string, puuid string) []string {
+	filtered := make([]string, 0, len(puuids))
+	for _, p := range puuids {
+		if p != puuid {
+			filtered = append(filtered, p)
+		}
+	}
+
+	return filtered
+}
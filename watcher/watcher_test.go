@@ -0,0 +1,201 @@
+package watcher
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Kinveil/Riot-API-Golang/apiclient"
+	"github.com/Kinveil/Riot-API-Golang/constants/region"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeClient serves a game of p1 and p2 that lasts for three spectator requests, published on the second match request.
+type fakeClient struct {
+	apiclient.Client
+	mutex     sync.Mutex
+	spectator int
+	matches   int
+	down      bool // Fails every request with a server error
+	priority  int
+}
+
+func (c *fakeClient) WithContext(ctx context.Context) apiclient.Client {
+	return c
+}
+
+func (c *fakeClient) WithPriority(priority int) apiclient.Client {
+	c.priority = priority
+	return c
+}
+
+func (c *fakeClient) GetSpectatorActiveGameByPuuid(r region.Region, puuid string) (*apiclient.ActiveGame, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	// Like the real client, an empty game is returned along with errors
+	c.spectator++
+	if c.down {
+		return &apiclient.ActiveGame{}, fmt.Errorf("status code 500: %w", apiclient.ErrInternalServerError)
+	}
+	if c.spectator > 3 || puuid == "p3" {
+		return &apiclient.ActiveGame{}, fmt.Errorf("status code 404: %w", apiclient.ErrNotFound)
+	}
+
+	p1, p2 := "p1", "p2"
+	return &apiclient.ActiveGame{
+		GameID:        1,
+		PlatformID:    region.EUW1,
+		GameStartTime: time.Now().UnixMilli(),
+		Participants:  []apiclient.ActiveGameParticipant{{Puuid: &p1}, {Puuid: &p2}},
+	}, nil
+}
+
+func (c *fakeClient) GetMatchByID(matchID apiclient.MatchID) (*apiclient.Match, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.matches++
+	if c.matches < 2 {
+		return &apiclient.Match{}, fmt.Errorf("status code 404: %w", apiclient.ErrNotFound)
+	}
+
+	match := &apiclient.Match{}
+	match.Metadata.MatchID = matchID
+	return match, nil
+}
+
+func TestRun(t *testing.T) {
+	client := &fakeClient{}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var events []Event
+	watcher := New(client, Options{
+		Concurrency:   1,
+		IdleInterval:  time.Hour,
+		EarlyInterval: 10 * time.Millisecond,
+		LateInterval:  10 * time.Millisecond,
+		MatchInterval: 10 * time.Millisecond,
+		OnEvent: func(event Event) {
+			events = append(events, event)
+			if event.Type == MatchAvailable && event.Puuid == "p2" {
+				cancel()
+			}
+		},
+		OnError: func(err error) {
+			t.Error(err)
+		},
+	})
+	watcher.Add(region.EUW1, "p1", "p2", "p3")
+
+	assert.ErrorIs(t, watcher.Run(ctx), context.Canceled)
+
+	byPlayer := make(map[string][]EventType)
+	for _, event := range events {
+		assert.Equal(t, apiclient.MatchID("EUW1_1"), event.MatchID)
+		byPlayer[event.Puuid] = append(byPlayer[event.Puuid], event.Type)
+	}
+
+	for _, puuid := range []string{"p1", "p2"} {
+		assert.Equal(t, []EventType{GameStarted, GameEnded, MatchAvailable}, byPlayer[puuid])
+	}
+	assert.Empty(t, byPlayer["p3"])
+
+	// p1 and p2 share the polls of their game, p3 is polled once
+	assert.LessOrEqual(t, client.spectator, 5)
+	assert.Equal(t, 2, client.matches)
+}
+
+func TestMatchMissing(t *testing.T) {
+	client := &fakeClient{matches: -100}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var events []Event
+	watcher := New(client, Options{
+		IdleInterval:  time.Hour,
+		LateInterval:  10 * time.Millisecond,
+		SurrenderTime: time.Millisecond,
+		MatchInterval: 10 * time.Millisecond,
+		MatchTimeout:  50 * time.Millisecond,
+		OnEvent: func(event Event) {
+			events = append(events, event)
+			if event.Type == MatchMissing {
+				cancel()
+			}
+		},
+	})
+	watcher.Add(region.EUW1, "p1")
+
+	assert.ErrorIs(t, watcher.Run(ctx), context.Canceled)
+	if assert.NotEmpty(t, events) {
+		assert.Equal(t, MatchMissing, events[len(events)-1].Type)
+		assert.Nil(t, events[len(events)-1].Match)
+	}
+}
+
+func TestRunServerErrors(t *testing.T) {
+	client := &fakeClient{down: true}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var events []Event
+	var errs []error
+	priority := 0
+	watcher := New(client, Options{
+		Priority:     &priority,
+		IdleInterval: 10 * time.Millisecond,
+		OnEvent: func(event Event) {
+			events = append(events, event)
+		},
+		OnError: func(err error) {
+			errs = append(errs, err)
+			if len(errs) == 3 {
+				cancel()
+			}
+		},
+	})
+	watcher.Add(region.EUW1, "p1")
+
+	assert.ErrorIs(t, watcher.Run(ctx), context.Canceled)
+	assert.Empty(t, events)
+	assert.ErrorIs(t, errs[0], apiclient.ErrInternalServerError)
+	assert.Equal(t, 0, client.priority)
+}
+
+// gameClient serves the games of players by PUUID.
+type gameClient struct {
+	apiclient.Client
+	games map[string]*apiclient.ActiveGame
+}
+
+func (c *gameClient) GetSpectatorActiveGameByPuuid(r region.Region, puuid string) (*apiclient.ActiveGame, error) {
+	return c.games[puuid], nil
+}
+
+func TestStalePlayerPoll(t *testing.T) {
+	p1, p2 := "p1", "p2"
+	client := &gameClient{games: map[string]*apiclient.ActiveGame{
+		// p1 is seen in an earlier game, while p2 is seen with p1 in the current one
+		"p1": {GameID: 1, PlatformID: region.EUW1, Participants: []apiclient.ActiveGameParticipant{{Puuid: &p1}}},
+		"p2": {GameID: 2, PlatformID: region.EUW1, Participants: []apiclient.ActiveGameParticipant{{Puuid: &p1}, {Puuid: &p2}}},
+	}}
+
+	watcher := New(client, Options{})
+	watcher.Add(region.EUW1, "p1", "p2")
+
+	stale := watcher.pollPlayer(client, watcher.subscriptions["p1"])
+	current := watcher.pollPlayer(client, watcher.subscriptions["p2"])
+
+	now := time.Now()
+	assert.Len(t, current.apply(now), 2)
+	assert.Empty(t, stale.apply(now))
+
+	// The earlier game has no players and is not polled
+	assert.Len(t, watcher.games, 1)
+	assert.Equal(t, []string{"p2", "p1"}, watcher.games["EUW1_2"].puuids)
+	assert.NotPanics(t, func() { watcher.due(now.Add(time.Hour), 10) })
+}